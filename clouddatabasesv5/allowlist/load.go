@@ -0,0 +1,164 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package allowlist keeps the IP allowlist of a Cloud Databases deployment in sync with a list of entries kept in a
+// YAML, JSON or CSV file, for example in a git repository.
+//
+// LoadFile reads the desired entries, NewPlan compares them with the current allowlist of a deployment, and
+// Plan.Apply makes the changes, or only prints them in dry-run mode:
+//
+//	desired, err := allowlist.LoadFile("allowlist.yaml")
+//	...
+//	plan, err := allowlist.NewPlan(ctx, cloudDatabasesService, deploymentID, desired)
+//	...
+//	tasks, err := plan.Apply(ctx, cloudDatabasesService, &allowlist.ApplyOptions{DryRun: true, Output: os.Stdout})
+package allowlist
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5/internal/declarative"
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Format : The format of an allowlist file.
+type Format = declarative.Format
+
+// Constants associated with the Format type.
+const (
+	// A YAML sequence of entries with "address" and "description" keys, or a mapping with the sequence under
+	// "ip_addresses".
+	FormatYAML = declarative.FormatYAML
+
+	// A JSON array of entries with "address" and "description" keys, or an object with the array under
+	// "ip_addresses", like the body of GetAllowlist.
+	FormatJSON = declarative.FormatJSON
+
+	// One entry per record, the address followed by an optional description. A first record starting with "address" is
+	// a header and is skipped, and lines starting with "#" are comments.
+	FormatCSV = declarative.FormatCSV
+)
+
+// FormatForPath returns the format of a file from its extension: ".yaml" or ".yml", ".json", or ".csv".
+func FormatForPath(path string) (Format, error) {
+	return declarative.FormatForPath(path, "allowlist", FormatYAML, FormatJSON, FormatCSV)
+}
+
+// LoadFile reads the allowlist entries from a file, in the format given by its extension. See Load.
+func LoadFile(path string) (entries []clouddatabasesv5.AllowlistEntry, err error) {
+	err = declarative.LoadFile(path, "allowlist", []Format{FormatYAML, FormatJSON, FormatCSV}, func(reader io.Reader, format Format) (err error) {
+		entries, err = Load(reader, format)
+		return
+	})
+	return
+}
+
+// Load reads allowlist entries in the specified format. The addresses are validated and normalized with
+// clouddatabasesv5.NormalizeAllowlist. Duplicate addresses and entries whose range lies inside the range of another
+// entry are an error, as they are for SetAllowlist.
+func Load(reader io.Reader, format Format) ([]clouddatabasesv5.AllowlistEntry, error) {
+	var entries []fileEntry
+	var err error
+	if format == FormatCSV {
+		entries, err = decodeCSV(reader)
+		if err != nil {
+			return nil, core.SDKErrorf(err, "", "decode-error", common.GetComponentInfo())
+		}
+	} else {
+		err = declarative.Decode(reader, format, "allowlist", "ip_addresses", &entries)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	allowlist := []clouddatabasesv5.AllowlistEntry{}
+	for i, entry := range entries {
+		if entry.Address == "" {
+			err = fmt.Errorf("entry %d has no address", i)
+			return nil, core.SDKErrorf(err, "", "missing-address", common.GetComponentInfo())
+		}
+		allowlistEntry := clouddatabasesv5.AllowlistEntry{Address: core.StringPtr(entry.Address)}
+		if entry.Description != "" {
+			allowlistEntry.Description = core.StringPtr(entry.Description)
+		}
+		allowlist = append(allowlist, allowlistEntry)
+	}
+
+	normalization, err := clouddatabasesv5.NormalizeAllowlist(allowlist)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "invalid-allowlist")
+	}
+	if len(normalization.Duplicates) > 0 {
+		err = fmt.Errorf("%w: duplicate entries for %s", clouddatabasesv5.ErrInvalidAllowlist, addresses(normalization.Duplicates))
+		return nil, core.SDKErrorf(err, "", "duplicate-allowlist-entry", common.GetComponentInfo())
+	}
+	if len(normalization.Covered) > 0 {
+		err = fmt.Errorf("%w: entries for %s lie inside the range of another entry", clouddatabasesv5.ErrInvalidAllowlist, addresses(normalization.Covered))
+		return nil, core.SDKErrorf(err, "", "covered-allowlist-entry", common.GetComponentInfo())
+	}
+	if normalization.Entries == nil {
+		return []clouddatabasesv5.AllowlistEntry{}, nil
+	}
+	return normalization.Entries, nil
+}
+
+// addresses returns the addresses of the entries, separated by commas.
+func addresses(entries []clouddatabasesv5.AllowlistEntry) string {
+	var addresses []string
+	for _, entry := range entries {
+		addresses = append(addresses, *entry.Address)
+	}
+	return strings.Join(addresses, ", ")
+}
+
+// fileEntry is an allowlist entry as written in a file.
+type fileEntry struct {
+	Address     string `json:"address" yaml:"address"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+func decodeCSV(reader io.Reader) ([]fileEntry, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.Comment = '#'
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) > 0 && strings.EqualFold(strings.TrimSpace(records[0][0]), "address") {
+		records = records[1:]
+	}
+
+	var entries []fileEntry
+	for _, record := range records {
+		if len(record) > 2 {
+			return nil, fmt.Errorf("record %q has %d fields, expected an address and an optional description", record, len(record))
+		}
+		entry := fileEntry{Address: strings.TrimSpace(record[0])}
+		if len(record) == 2 {
+			entry.Description = strings.TrimSpace(record[1])
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,120 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package allowlist
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedEntries = []clouddatabasesv5.AllowlistEntry{
+	{Address: core.StringPtr("10.0.0.0/8"), Description: core.StringPtr("vpn")},
+	{Address: core.StringPtr("203.0.113.7"), Description: core.StringPtr("ci, build runners")},
+	{Address: core.StringPtr("2001:db8::/32")},
+}
+
+func TestLoadFormats(t *testing.T) {
+	documents := map[Format][]string{
+		FormatYAML: {
+			`
+- address: 10.1.2.3/8
+  description: vpn
+- address: 203.0.113.7/32
+  description: ci, build runners
+- address: 2001:DB8::/32
+`,
+			`
+ip_addresses:
+  - {address: 10.0.0.0/8, description: vpn}
+  - {address: 203.0.113.7, description: "ci, build runners"}
+  - {address: "2001:db8::/32"}
+`,
+		},
+		FormatJSON: {
+			`[{"address": "10.0.0.0/8", "description": "vpn"}, {"address": "203.0.113.7", "description": "ci, build runners"}, {"address": "2001:db8::/32"}]`,
+			`{"ip_addresses": [{"address": "10.0.0.0/8", "description": "vpn"}, {"address": "203.0.113.7", "description": "ci, build runners"}, {"address": "2001:db8::/32"}]}`,
+		},
+		FormatCSV: {
+			"address,description\n10.0.0.0/8,vpn\n# CI\n203.0.113.7, \"ci, build runners\"\n2001:db8::/32\n",
+			"10.0.0.0/8,vpn\n203.0.113.7,\"ci, build runners\"\n2001:db8::/32,\n",
+		},
+	}
+	for format, formatDocuments := range documents {
+		for _, document := range formatDocuments {
+			entries, err := Load(strings.NewReader(document), format)
+			require.Nil(t, err, document)
+			assert.Equal(t, expectedEntries, entries, document)
+		}
+	}
+}
+
+func TestLoadEmpty(t *testing.T) {
+	for _, format := range []Format{FormatYAML, FormatJSON, FormatCSV} {
+		entries, err := Load(strings.NewReader(""), format)
+		require.Nil(t, err)
+		assert.Empty(t, entries)
+		assert.NotNil(t, entries)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	documents := map[string]Format{
+		`- {adress: 10.0.0.0/8}`:                                FormatYAML,
+		`- address: 10.0.0.0/33`:                                FormatYAML,
+		`address: 10.0.0.0`:                                     FormatYAML,
+		`[{"address": "10.0.0.1"}, {"address": "10.0.0.1/32"}]`: FormatJSON,
+		`{"ip_addresses": [{"description": "no address"}]}`:     FormatJSON,
+		"10.0.0.0/8,vpn,extra\n":                                FormatCSV,
+		"10.0.0.0/8":                                            "toml",
+	}
+	for document, format := range documents {
+		_, err := Load(strings.NewReader(document), format)
+		assert.NotNil(t, err, document)
+	}
+
+	_, err := Load(strings.NewReader(`[{"address": "10.0.0.1"}, {"address": "10.0.0.1/32"}]`), FormatJSON)
+	assert.True(t, errors.Is(err, clouddatabasesv5.ErrInvalidAllowlist))
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "allowlist.yml")
+	require.Nil(t, os.WriteFile(path, []byte("- {address: 10.0.0.0/8, description: vpn}\n"), 0600))
+
+	entries, err := LoadFile(path)
+	require.Nil(t, err)
+	assert.Equal(t, expectedEntries[:1], entries)
+
+	_, err = LoadFile(filepath.Join(dir, "allowlist.txt"))
+	assert.NotNil(t, err)
+	_, err = LoadFile(filepath.Join(dir, "missing.json"))
+	assert.NotNil(t, err)
+}
+
+func TestLoadRejectsCoveredRanges(t *testing.T) {
+	_, err := Load(strings.NewReader("10.20.0.0/16,team\n10.0.0.0/8,vpn\n"), FormatCSV)
+	assert.True(t, errors.Is(err, clouddatabasesv5.ErrInvalidAllowlist))
+	assert.Contains(t, err.Error(), "10.20.0.0/16")
+}
@@ -0,0 +1,280 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package allowlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5/internal/declarative"
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ErrUnsupportedChange is wrapped by the error that Plan.Apply returns when the plan has a change that the apply mode
+// cannot make; use errors.Is to detect it.
+var ErrUnsupportedChange = errors.New("change not supported by the apply mode")
+
+// Plan : The changes that make the allowlist of a deployment match a desired allowlist.
+// Entries are matched on their normalized address.
+type Plan struct {
+	// The ID of the deployment.
+	DeploymentID string
+
+	// The allowlist of the deployment when the plan was made.
+	Current []clouddatabasesv5.AllowlistEntry
+
+	// The ETag of the current allowlist.
+	ETag string
+
+	// The desired allowlist.
+	Desired []clouddatabasesv5.AllowlistEntry
+
+	// Desired entries that are missing from the current allowlist.
+	Add []clouddatabasesv5.AllowlistEntry
+
+	// Current entries that are not in the desired allowlist.
+	Remove []clouddatabasesv5.AllowlistEntry
+
+	// Entries that are in both allowlists with a different description.
+	Update []DescriptionChange
+}
+
+// DescriptionChange : A change to the description of an allowlist entry.
+type DescriptionChange struct {
+	// The current entry.
+	Current clouddatabasesv5.AllowlistEntry
+
+	// The desired entry.
+	Desired clouddatabasesv5.AllowlistEntry
+}
+
+// ApplyMode : How Plan.Apply makes the changes.
+type ApplyMode string
+
+// Constants associated with the ApplyMode type.
+const (
+	// Replace the whole allowlist with one SetAllowlist request, made with the ETag of the plan so that it fails if the
+	// allowlist changed since the plan was made.
+	ApplyModeSet ApplyMode = "set"
+
+	// Make one AddAllowlistEntry or DeleteAllowlistEntry request per change, adding entries before removing any.
+	// Description changes cannot be made entry by entry: plans with updates are refused, apply them with ApplyModeSet.
+	ApplyModeEntries ApplyMode = "entries"
+)
+
+// ApplyOptions : The Plan.Apply options.
+type ApplyOptions struct {
+	// How to make the changes. Defaults to ApplyModeSet.
+	Mode ApplyMode
+
+	// Only write the diff of the plan to Output, without making any change.
+	DryRun bool
+
+	// Where the diff is written in dry-run mode. Required when DryRun is set.
+	Output io.Writer
+
+	// The options used to wait for each task. See clouddatabasesv5.CloudDatabasesV5.WaitForTask.
+	WaitForTaskOptions *clouddatabasesv5.WaitForTaskOptions
+}
+
+// NewPlan gets the allowlist of the deployment and compares it with the desired entries, as returned by Load. Every
+// desired entry must have an address.
+func NewPlan(ctx context.Context, api clouddatabasesv5.CloudDatabasesAPI, id string, desired []clouddatabasesv5.AllowlistEntry) (plan *Plan, err error) {
+	for i, entry := range desired {
+		if entry.Address == nil {
+			err = fmt.Errorf("%w: desired entry %d has no address", clouddatabasesv5.ErrInvalidAllowlist, i)
+			err = core.SDKErrorf(err, "", "missing-allowlist-address", common.GetComponentInfo())
+			return
+		}
+	}
+
+	getAllowlistOptions := (*clouddatabasesv5.CloudDatabasesV5)(nil).NewGetAllowlistOptions(id)
+	current, response, err := api.GetAllowlistWithContext(ctx, getAllowlistOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-allowlist-error")
+		return
+	}
+
+	plan = &Plan{
+		DeploymentID: id,
+		ETag:         response.GetHeaders().Get("ETag"),
+		Desired:      desired,
+	}
+	if current != nil {
+		plan.Current = current.IPAddresses
+	}
+
+	currentByAddress := map[string]clouddatabasesv5.AllowlistEntry{}
+	for _, entry := range plan.Current {
+		currentByAddress[normalizedAddress(entry)] = entry
+	}
+	desiredByAddress := map[string]bool{}
+	for _, entry := range desired {
+		address := normalizedAddress(entry)
+		desiredByAddress[address] = true
+		currentEntry, ok := currentByAddress[address]
+		if !ok {
+			plan.Add = append(plan.Add, entry)
+		} else if core.StringNilMapper(currentEntry.Description) != core.StringNilMapper(entry.Description) {
+			plan.Update = append(plan.Update, DescriptionChange{Current: currentEntry, Desired: entry})
+		}
+	}
+	for _, entry := range plan.Current {
+		if !desiredByAddress[normalizedAddress(entry)] {
+			plan.Remove = append(plan.Remove, entry)
+		}
+	}
+	return
+}
+
+// IsEmpty returns true if the allowlist already matches the desired allowlist.
+func (plan *Plan) IsEmpty() bool {
+	return len(plan.Add) == 0 && len(plan.Remove) == 0 && len(plan.Update) == 0
+}
+
+// String returns a readable diff of the plan: one line per added ("+"), removed ("-") or updated ("~") entry.
+func (plan *Plan) String() string {
+	builder := &strings.Builder{}
+	fmt.Fprintf(builder, "Allowlist of %s:\n", plan.DeploymentID)
+	if plan.IsEmpty() {
+		builder.WriteString("  no changes\n")
+		return builder.String()
+	}
+
+	writer := tabwriter.NewWriter(builder, 0, 0, 2, ' ', 0)
+	for _, entry := range plan.Add {
+		fmt.Fprintf(writer, "+ %s\t%q\n", *entry.Address, core.StringNilMapper(entry.Description))
+	}
+	for _, change := range plan.Update {
+		fmt.Fprintf(writer, "~ %s\t%q -> %q\n", *change.Current.Address, core.StringNilMapper(change.Current.Description), core.StringNilMapper(change.Desired.Description))
+	}
+	for _, entry := range plan.Remove {
+		fmt.Fprintf(writer, "- %s\t%q\n", *entry.Address, core.StringNilMapper(entry.Description))
+	}
+	writer.Flush()
+	fmt.Fprintf(builder, "%d to add, %d to update, %d to remove\n", len(plan.Add), len(plan.Update), len(plan.Remove))
+	return builder.String()
+}
+
+// Apply makes the changes of the plan and waits for each resulting task. It returns the completed tasks, which are
+// also returned along with the error if a request or a task fails. Nothing is changed when the plan is empty or in
+// dry-run mode.
+func (plan *Plan) Apply(ctx context.Context, api clouddatabasesv5.CloudDatabasesAPI, options *ApplyOptions) (tasks []clouddatabasesv5.Task, err error) {
+	if options == nil {
+		options = &ApplyOptions{}
+	}
+
+	if options.DryRun {
+		err = declarative.WriteDryRun(options.Output, plan)
+		return
+	}
+	if plan.IsEmpty() {
+		return
+	}
+
+	switch options.Mode {
+	case ApplyModeSet, "":
+		return plan.applySet(ctx, api, options.WaitForTaskOptions)
+	case ApplyModeEntries:
+		return plan.applyEntries(ctx, api, options.WaitForTaskOptions)
+	}
+	err = core.SDKErrorf(nil, fmt.Sprintf("unknown apply mode '%s'", options.Mode), "unknown-mode", common.GetComponentInfo())
+	return
+}
+
+// Sync makes a plan for the deployment and applies it. The plan is returned along with the tasks.
+func Sync(ctx context.Context, api clouddatabasesv5.CloudDatabasesAPI, id string, desired []clouddatabasesv5.AllowlistEntry, options *ApplyOptions) (plan *Plan, tasks []clouddatabasesv5.Task, err error) {
+	return declarative.Sync(func() (*Plan, error) {
+		return NewPlan(ctx, api, id, desired)
+	}, func(plan *Plan) ([]clouddatabasesv5.Task, error) {
+		return plan.Apply(ctx, api, options)
+	})
+}
+
+func (plan *Plan) applySet(ctx context.Context, api clouddatabasesv5.CloudDatabasesAPI, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (tasks []clouddatabasesv5.Task, err error) {
+	setAllowlistOptions := (*clouddatabasesv5.CloudDatabasesV5)(nil).NewSetAllowlistOptions(plan.DeploymentID)
+	setAllowlistOptions.SetIPAddresses(plan.Desired)
+	if setAllowlistOptions.IPAddresses == nil {
+		setAllowlistOptions.SetIPAddresses([]clouddatabasesv5.AllowlistEntry{})
+	}
+	if plan.ETag != "" {
+		setAllowlistOptions.SetIfMatch(plan.ETag)
+	}
+
+	_, task, _, err := api.SetAllowlistAndWait(ctx, setAllowlistOptions, waitForTaskOptions)
+	tasks = appendTask(tasks, task)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "set-allowlist-error")
+	}
+	return
+}
+
+func (plan *Plan) applyEntries(ctx context.Context, api clouddatabasesv5.CloudDatabasesAPI, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (tasks []clouddatabasesv5.Task, err error) {
+	if len(plan.Update) > 0 {
+		err = fmt.Errorf("%w: %d description changes cannot be applied entry by entry, use ApplyModeSet", ErrUnsupportedChange, len(plan.Update))
+		err = core.SDKErrorf(err, "", "entries-mode-update", common.GetComponentInfo())
+		return
+	}
+
+	add := func(entry clouddatabasesv5.AllowlistEntry) error {
+		addAllowlistEntryOptions := (*clouddatabasesv5.CloudDatabasesV5)(nil).NewAddAllowlistEntryOptions(plan.DeploymentID)
+		addAllowlistEntryOptions.SetIPAddress(&entry)
+		_, task, _, err := api.AddAllowlistEntryAndWait(ctx, addAllowlistEntryOptions, waitForTaskOptions)
+		tasks = appendTask(tasks, task)
+		return core.RepurposeSDKProblem(err, "add-allowlist-entry-error")
+	}
+	remove := func(entry clouddatabasesv5.AllowlistEntry) error {
+		deleteAllowlistEntryOptions := (*clouddatabasesv5.CloudDatabasesV5)(nil).NewDeleteAllowlistEntryOptions(plan.DeploymentID, *entry.Address)
+		_, task, _, err := api.DeleteAllowlistEntryAndWait(ctx, deleteAllowlistEntryOptions, waitForTaskOptions)
+		tasks = appendTask(tasks, task)
+		return core.RepurposeSDKProblem(err, "delete-allowlist-entry-error")
+	}
+
+	for _, entry := range plan.Add {
+		if err = add(entry); err != nil {
+			return
+		}
+	}
+	for _, entry := range plan.Remove {
+		if err = remove(entry); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func appendTask(tasks []clouddatabasesv5.Task, task *clouddatabasesv5.Task) []clouddatabasesv5.Task {
+	if task != nil {
+		tasks = append(tasks, *task)
+	}
+	return tasks
+}
+
+// normalizedAddress returns the normalized address of the entry, or its address as is if it is invalid.
+func normalizedAddress(entry clouddatabasesv5.AllowlistEntry) string {
+	address := core.StringNilMapper(entry.Address)
+	if normalized, err := clouddatabasesv5.NormalizeAllowlistAddress(address); err == nil {
+		return normalized
+	}
+	return address
+}
@@ -0,0 +1,130 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package allowlist
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5/fake"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const deploymentID = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/abc123:d1a2b3c4-0000-4000-8000-000000000001::"
+
+var (
+	office   = clouddatabasesv5.AllowlistEntry{Address: core.StringPtr("192.168.0.0/16"), Description: core.StringPtr("office")}
+	vpn      = clouddatabasesv5.AllowlistEntry{Address: core.StringPtr("10.0.0.0/8"), Description: core.StringPtr("vpn")}
+	ci       = clouddatabasesv5.AllowlistEntry{Address: core.StringPtr("203.0.113.7"), Description: core.StringPtr("ci")}
+	ciRunner = clouddatabasesv5.AllowlistEntry{Address: core.StringPtr("203.0.113.7"), Description: core.StringPtr("ci runner")}
+)
+
+func newTestService(t *testing.T) (*fake.Server, *clouddatabasesv5.CloudDatabasesV5) {
+	server, service := fake.NewTestService(t, nil, clouddatabasesv5.Deployment{ID: core.StringPtr(deploymentID), Type: core.StringPtr("postgresql")})
+	server.SetAllowlist(deploymentID, []clouddatabasesv5.AllowlistEntry{office, ci})
+	return server, service
+}
+
+func newApplyOptions(mode ApplyMode) *ApplyOptions {
+	return &ApplyOptions{
+		Mode:               mode,
+		WaitForTaskOptions: (*clouddatabasesv5.CloudDatabasesV5)(nil).NewWaitForTaskOptions().SetPollInterval(time.Millisecond),
+	}
+}
+
+func TestPlan(t *testing.T) {
+	_, service := newTestService(t)
+
+	plan, err := NewPlan(context.Background(), service, deploymentID, []clouddatabasesv5.AllowlistEntry{vpn, ciRunner})
+	require.Nil(t, err)
+	assert.Equal(t, []clouddatabasesv5.AllowlistEntry{vpn}, plan.Add)
+	assert.Equal(t, []clouddatabasesv5.AllowlistEntry{office}, plan.Remove)
+	assert.Equal(t, []DescriptionChange{{Current: ci, Desired: ciRunner}}, plan.Update)
+	assert.NotEmpty(t, plan.ETag)
+	assert.False(t, plan.IsEmpty())
+
+	output := &bytes.Buffer{}
+	tasks, err := plan.Apply(context.Background(), service, &ApplyOptions{DryRun: true, Output: output})
+	require.Nil(t, err)
+	assert.Empty(t, tasks)
+	assert.Equal(t, "Allowlist of "+deploymentID+":\n"+
+		`+ 10.0.0.0/8      "vpn"`+"\n"+
+		`~ 203.0.113.7     "ci" -> "ci runner"`+"\n"+
+		`- 192.168.0.0/16  "office"`+"\n"+
+		"1 to add, 1 to update, 1 to remove\n", output.String())
+
+	_, err = plan.Apply(context.Background(), service, &ApplyOptions{DryRun: true})
+	assert.NotNil(t, err)
+
+	plan, err = NewPlan(context.Background(), service, deploymentID, []clouddatabasesv5.AllowlistEntry{ci, office})
+	require.Nil(t, err)
+	assert.True(t, plan.IsEmpty())
+	assert.Contains(t, plan.String(), "no changes")
+}
+
+func TestApplySet(t *testing.T) {
+	server, service := newTestService(t)
+
+	plan, tasks, err := Sync(context.Background(), service, deploymentID, []clouddatabasesv5.AllowlistEntry{vpn, ciRunner}, newApplyOptions(ApplyModeSet))
+	require.Nil(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, clouddatabasesv5.TaskStatusCompletedConst, *tasks[0].Status)
+	assert.Equal(t, []clouddatabasesv5.AllowlistEntry{vpn, ciRunner}, server.Allowlist(deploymentID))
+
+	// The plan is stale once the allowlist has changed.
+	_, err = plan.Apply(context.Background(), service, newApplyOptions(ApplyModeSet))
+	assert.NotNil(t, err)
+}
+
+func TestNewPlanRejectsEntriesWithoutAddress(t *testing.T) {
+	server, service := newTestService(t)
+
+	plan, err := NewPlan(context.Background(), service, deploymentID, []clouddatabasesv5.AllowlistEntry{vpn, {Description: core.StringPtr("no address")}})
+	assert.True(t, errors.Is(err, clouddatabasesv5.ErrInvalidAllowlist))
+	assert.Nil(t, plan)
+	assert.Empty(t, server.Tasks(deploymentID))
+}
+
+func TestApplyEntries(t *testing.T) {
+	server, service := newTestService(t)
+
+	_, tasks, err := Sync(context.Background(), service, deploymentID, []clouddatabasesv5.AllowlistEntry{vpn, ci}, newApplyOptions(ApplyModeEntries))
+	require.Nil(t, err)
+	assert.Len(t, tasks, 2)
+	assert.ElementsMatch(t, []clouddatabasesv5.AllowlistEntry{vpn, ci}, server.Allowlist(deploymentID))
+
+	_, tasks, err = Sync(context.Background(), service, deploymentID, []clouddatabasesv5.AllowlistEntry{vpn, ci}, newApplyOptions(ApplyModeEntries))
+	require.Nil(t, err)
+	assert.Empty(t, tasks)
+}
+
+func TestApplyEntriesRefusesUpdates(t *testing.T) {
+	server, service := newTestService(t)
+
+	// The entry of ci would be deleted before it is added again with its new description.
+	_, tasks, err := Sync(context.Background(), service, deploymentID, []clouddatabasesv5.AllowlistEntry{vpn, ciRunner}, newApplyOptions(ApplyModeEntries))
+	assert.True(t, errors.Is(err, ErrUnsupportedChange))
+	assert.False(t, errors.Is(err, clouddatabasesv5.ErrValidation))
+	assert.Empty(t, tasks)
+	assert.Equal(t, []clouddatabasesv5.AllowlistEntry{office, ci}, server.Allowlist(deploymentID))
+}
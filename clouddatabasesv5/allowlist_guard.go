@@ -0,0 +1,263 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ErrAllowlistLockout is wrapped by the error returned when the allowlist guard refuses a change.
+var ErrAllowlistLockout = errors.New("allowlist change would lock out protected addresses")
+
+// AllowlistGuard : An opt-in safeguard against allowlist changes that cut off the caller.
+// Once set on a client with SetAllowlistGuard, SetAllowlist and DeleteAllowlistEntry refuse any change that leaves a
+// protected address outside of the resulting allowlist, unless Force is set on their options. An empty allowlist
+// accepts connections from any address, so emptying the allowlist is never refused.
+type AllowlistGuard struct {
+	// IP addresses or CIDR ranges that must stay covered by the allowlist.
+	MustKeep []string
+
+	// Also protect the egress IP address of this host, as returned by EgressIPURL. The address is detected once and
+	// reused for later checks. It is not supported by clients of private endpoints, whose requests do not leave from
+	// the public egress IP address: add the address of this host to MustKeep instead.
+	KeepEgressIP bool
+
+	// The URL of a service that returns the IP address of the caller as plain text. Required with KeepEgressIP.
+	EgressIPURL string
+
+	// The HTTP client used to call EgressIPURL. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	mutex    sync.Mutex
+	egressIP string
+}
+
+// SetAllowlistGuard sets the guard checked by SetAllowlist and DeleteAllowlistEntry. A nil guard disables the check.
+func (cloudDatabases *CloudDatabasesV5) SetAllowlistGuard(guard *AllowlistGuard) {
+	cloudDatabases.allowlistGuard = guard
+}
+
+// GetAllowlistGuard returns the guard set with SetAllowlistGuard.
+func (cloudDatabases *CloudDatabasesV5) GetAllowlistGuard() *AllowlistGuard {
+	return cloudDatabases.allowlistGuard
+}
+
+// SetForce : Allow user to set Force
+func (_options *SetAllowlistOptions) SetForce(force bool) *SetAllowlistOptions {
+	_options.Force = core.BoolPtr(force)
+	return _options
+}
+
+// SetForce : Allow user to set Force
+func (_options *DeleteAllowlistEntryOptions) SetForce(force bool) *DeleteAllowlistEntryOptions {
+	_options.Force = core.BoolPtr(force)
+	return _options
+}
+
+// Check returns an error wrapping ErrAllowlistLockout if a protected address is not covered by the allowlist.
+func (guard *AllowlistGuard) Check(ctx context.Context, entries []AllowlistEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	protected, err := guard.protectedAddresses(ctx)
+	if err != nil {
+		return err
+	}
+
+	var nets []*net.IPNet
+	for _, entry := range entries {
+		ipNet, err := parseAllowlistAddress(core.StringNilMapper(entry.Address))
+		if err != nil {
+			return core.SDKErrorf(err, "", "invalid-allowlist-address", common.GetComponentInfo())
+		}
+		nets = append(nets, ipNet)
+	}
+
+	var lockedOut []string
+	for _, address := range protected {
+		ipNet, err := parseAllowlistAddress(address.address)
+		if err != nil {
+			err = fmt.Errorf("allowlist guard: %w", err)
+			return core.SDKErrorf(err, "", "invalid-guard-address", common.GetComponentInfo())
+		}
+		covered := false
+		for _, entryNet := range nets {
+			if netContains(entryNet, ipNet) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			lockedOut = append(lockedOut, address.String())
+		}
+	}
+	if len(lockedOut) > 0 {
+		err = fmt.Errorf("%w: %s not covered by the resulting allowlist; set Force to apply the change anyway", ErrAllowlistLockout, strings.Join(lockedOut, ", "))
+		return core.SDKErrorf(err, "", "allowlist-lockout", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// DetectEgressIP returns the IP address of this host as seen by the service at EgressIPURL. The address is only
+// requested the first time.
+func (guard *AllowlistGuard) DetectEgressIP(ctx context.Context) (string, error) {
+	guard.mutex.Lock()
+	defer guard.mutex.Unlock()
+	if guard.egressIP != "" {
+		return guard.egressIP, nil
+	}
+
+	egressIPURL := guard.EgressIPURL
+	if egressIPURL == "" {
+		return "", core.SDKErrorf(nil, "the allowlist guard has no EgressIPURL to detect the egress IP address", "egress-ip-error", common.GetComponentInfo())
+	}
+	client := guard.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, egressIPURL, nil)
+	if err != nil {
+		return "", core.SDKErrorf(err, "", "egress-ip-error", common.GetComponentInfo())
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return "", core.SDKErrorf(err, "", "egress-ip-error", common.GetComponentInfo())
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		err = fmt.Errorf("%s returned status %d", egressIPURL, response.StatusCode)
+		return "", core.SDKErrorf(err, "", "egress-ip-error", common.GetComponentInfo())
+	}
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, 256))
+	if err != nil {
+		return "", core.SDKErrorf(err, "", "egress-ip-error", common.GetComponentInfo())
+	}
+	ip := net.ParseIP(strings.TrimSpace(string(body)))
+	if ip == nil {
+		err = fmt.Errorf("%s did not return an IP address", egressIPURL)
+		return "", core.SDKErrorf(err, "", "egress-ip-error", common.GetComponentInfo())
+	}
+	guard.egressIP = ip.String()
+	return guard.egressIP, nil
+}
+
+type protectedAddress struct {
+	address string
+	egress  bool
+}
+
+func (address protectedAddress) String() string {
+	if address.egress {
+		return address.address + " (egress IP)"
+	}
+	return address.address
+}
+
+func (guard *AllowlistGuard) protectedAddresses(ctx context.Context) ([]protectedAddress, error) {
+	var protected []protectedAddress
+	for _, address := range guard.MustKeep {
+		protected = append(protected, protectedAddress{address: address})
+	}
+	if guard.KeepEgressIP {
+		egressIP, err := guard.DetectEgressIP(ctx)
+		if err != nil {
+			return nil, err
+		}
+		protected = append(protected, protectedAddress{address: egressIP, egress: true})
+	}
+	return protected, nil
+}
+
+// guardSetAllowlist checks the allowlist guard, if any, against the allowlist about to be set.
+func (cloudDatabases *CloudDatabasesV5) guardSetAllowlist(ctx context.Context, setAllowlistOptions *SetAllowlistOptions) error {
+	guard := cloudDatabases.allowlistGuard
+	if guard == nil || (setAllowlistOptions.Force != nil && *setAllowlistOptions.Force) {
+		return nil
+	}
+	err := cloudDatabases.checkAllowlistGuard()
+	if err != nil {
+		return err
+	}
+	return guard.Check(ctx, setAllowlistOptions.IPAddresses)
+}
+
+// guardDeleteAllowlistEntry checks the allowlist guard, if any, against the current allowlist of the deployment
+// without the entry about to be deleted.
+func (cloudDatabases *CloudDatabasesV5) guardDeleteAllowlistEntry(ctx context.Context, deleteAllowlistEntryOptions *DeleteAllowlistEntryOptions) error {
+	guard := cloudDatabases.allowlistGuard
+	if guard == nil || (deleteAllowlistEntryOptions.Force != nil && *deleteAllowlistEntryOptions.Force) {
+		return nil
+	}
+	err := cloudDatabases.checkAllowlistGuard()
+	if err != nil {
+		return err
+	}
+
+	deleted, err := NormalizeAllowlistAddress(*deleteAllowlistEntryOptions.Ipaddress)
+	if err != nil {
+		return err
+	}
+	getAllowlistOptions := cloudDatabases.NewGetAllowlistOptions(*deleteAllowlistEntryOptions.ID)
+	current, _, err := cloudDatabases.GetAllowlistWithContext(ctx, getAllowlistOptions)
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "get-allowlist-error")
+	}
+	if current == nil {
+		// No allowlist in the response is an empty allowlist: nothing is removed.
+		return nil
+	}
+
+	var remaining []AllowlistEntry
+	for _, entry := range current.IPAddresses {
+		address, err := NormalizeAllowlistAddress(core.StringNilMapper(entry.Address))
+		if err != nil || address != deleted {
+			remaining = append(remaining, entry)
+		}
+	}
+	if len(remaining) == len(current.IPAddresses) {
+		// Nothing is removed, the service reports the missing entry.
+		return nil
+	}
+	return guard.Check(ctx, remaining)
+}
+
+// checkAllowlistGuard returns an error if the allowlist guard protects the egress IP address of a client of a private
+// endpoint.
+func (cloudDatabases *CloudDatabasesV5) checkAllowlistGuard() error {
+	if !cloudDatabases.allowlistGuard.KeepEgressIP {
+		return nil
+	}
+	serviceURL, err := url.Parse(cloudDatabases.Service.GetServiceURL())
+	if err == nil && strings.Contains(serviceURL.Hostname(), ".private.") {
+		return core.SDKErrorf(nil, "KeepEgressIP is not supported by clients of private endpoints; add the address of this host to MustKeep", "private-endpoint-egress-ip", common.GetComponentInfo())
+	}
+	return nil
+}
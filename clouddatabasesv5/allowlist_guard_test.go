@@ -0,0 +1,158 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5/fake"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AllowlistGuard`, func() {
+	const deploymentID = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/abc123:d1a2b3c4-0000-4000-8000-000000000001::"
+
+	var server *fake.Server
+	var egressServer *httptest.Server
+	var egressRequests int
+	var cloudDatabasesService *clouddatabasesv5.CloudDatabasesV5
+
+	office := clouddatabasesv5.AllowlistEntry{Address: core.StringPtr("192.168.0.0/16"), Description: core.StringPtr("office")}
+	ci := clouddatabasesv5.AllowlistEntry{Address: core.StringPtr("203.0.113.7"), Description: core.StringPtr("ci")}
+
+	BeforeEach(func() {
+		server = fake.NewServer(nil)
+		server.AddDeployment(clouddatabasesv5.Deployment{ID: core.StringPtr(deploymentID), Type: core.StringPtr("postgresql")})
+		server.SetAllowlist(deploymentID, []clouddatabasesv5.AllowlistEntry{office, ci})
+		egressRequests = 0
+		egressServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			egressRequests++
+			fmt.Fprint(res, "203.0.113.7\n")
+		}))
+
+		var err error
+		cloudDatabasesService, err = clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		cloudDatabasesService.SetAllowlistGuard(&clouddatabasesv5.AllowlistGuard{
+			MustKeep:     []string{"192.168.10.0/24"},
+			KeepEgressIP: true,
+			EgressIPURL:  egressServer.URL,
+		})
+	})
+	AfterEach(func() {
+		server.Close()
+		egressServer.Close()
+	})
+
+	It(`Detect the egress IP address once`, func() {
+		for i := 0; i < 2; i++ {
+			egressIP, err := cloudDatabasesService.GetAllowlistGuard().DetectEgressIP(context.Background())
+			Expect(err).To(BeNil())
+			Expect(egressIP).To(Equal("203.0.113.7"))
+		}
+		Expect(egressRequests).To(Equal(1))
+
+		_, err := (&clouddatabasesv5.AllowlistGuard{KeepEgressIP: true}).DetectEgressIP(context.Background())
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Allow changes that keep the protected addresses`, func() {
+		setAllowlistOptions := cloudDatabasesService.NewSetAllowlistOptions(deploymentID)
+		setAllowlistOptions.SetIPAddresses([]clouddatabasesv5.AllowlistEntry{{Address: core.StringPtr("192.168.0.0/20")}, {Address: core.StringPtr("203.0.113.0/24")}})
+		_, _, err := cloudDatabasesService.SetAllowlist(setAllowlistOptions)
+		Expect(err).To(BeNil())
+
+		setAllowlistOptions.SetIPAddresses([]clouddatabasesv5.AllowlistEntry{})
+		_, _, err = cloudDatabasesService.SetAllowlist(setAllowlistOptions)
+		Expect(err).To(BeNil())
+	})
+	It(`Refuse to set an allowlist that locks out the protected addresses`, func() {
+		setAllowlistOptions := cloudDatabasesService.NewSetAllowlistOptions(deploymentID)
+		setAllowlistOptions.SetIPAddresses([]clouddatabasesv5.AllowlistEntry{{Address: core.StringPtr("10.0.0.0/8")}, office})
+		_, _, err := cloudDatabasesService.SetAllowlist(setAllowlistOptions)
+		Expect(errors.Is(err, clouddatabasesv5.ErrAllowlistLockout)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("203.0.113.7 (egress IP)"))
+		Expect(err.Error()).ToNot(ContainSubstring("192.168.10.0/24"))
+		Expect(server.Allowlist(deploymentID)).To(Equal([]clouddatabasesv5.AllowlistEntry{office, ci}))
+
+		setAllowlistOptions.SetForce(true)
+		_, _, err = cloudDatabasesService.SetAllowlist(setAllowlistOptions)
+		Expect(err).To(BeNil())
+	})
+	It(`Refuse to delete an entry that covers a protected address`, func() {
+		deleteAllowlistEntryOptions := cloudDatabasesService.NewDeleteAllowlistEntryOptions(deploymentID, "192.168.0.0/16")
+		_, _, err := cloudDatabasesService.DeleteAllowlistEntry(deleteAllowlistEntryOptions)
+		Expect(errors.Is(err, clouddatabasesv5.ErrAllowlistLockout)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("192.168.10.0/24"))
+		Expect(server.Allowlist(deploymentID)).To(Equal([]clouddatabasesv5.AllowlistEntry{office, ci}))
+
+		deleteAllowlistEntryOptions.SetForce(true)
+		_, _, err = cloudDatabasesService.DeleteAllowlistEntry(deleteAllowlistEntryOptions)
+		Expect(err).To(BeNil())
+	})
+	It(`Refuse changes when the egress IP address cannot be detected`, func() {
+		egressServer.Close()
+		setAllowlistOptions := cloudDatabasesService.NewSetAllowlistOptions(deploymentID)
+		setAllowlistOptions.SetIPAddresses([]clouddatabasesv5.AllowlistEntry{office, ci})
+		_, _, err := cloudDatabasesService.SetAllowlist(setAllowlistOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, clouddatabasesv5.ErrAllowlistLockout)).To(BeFalse())
+		Expect(server.Tasks(deploymentID)).To(BeEmpty())
+	})
+	It(`Refuse to protect the egress IP address of a private endpoint client`, func() {
+		Expect(cloudDatabasesService.SetServiceURL("https://api.us-south.private.databases.cloud.ibm.com/v5/ibm")).To(BeNil())
+		setAllowlistOptions := cloudDatabasesService.NewSetAllowlistOptions(deploymentID)
+		setAllowlistOptions.SetIPAddresses([]clouddatabasesv5.AllowlistEntry{office, ci})
+		_, _, err := cloudDatabasesService.SetAllowlist(setAllowlistOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("private endpoints"))
+		Expect(egressRequests).To(Equal(0))
+	})
+	It(`Check nothing without a guard`, func() {
+		cloudDatabasesService.SetAllowlistGuard(nil)
+		deleteAllowlistEntryOptions := cloudDatabasesService.NewDeleteAllowlistEntryOptions(deploymentID, "203.0.113.7")
+		_, _, err := cloudDatabasesService.DeleteAllowlistEntry(deleteAllowlistEntryOptions)
+		Expect(err).To(BeNil())
+		Expect(server.Allowlist(deploymentID)).To(Equal([]clouddatabasesv5.AllowlistEntry{office}))
+	})
+	It(`Treat a response without an allowlist as an empty allowlist`, func() {
+		emptyServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			if req.Method == http.MethodGet {
+				res.WriteHeader(204)
+				return
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(202)
+			fmt.Fprint(res, `{"task": {"id": "task-id"}}`)
+		}))
+		defer emptyServer.Close()
+		Expect(cloudDatabasesService.SetServiceURL(emptyServer.URL)).To(BeNil())
+
+		deleteAllowlistEntryOptions := cloudDatabasesService.NewDeleteAllowlistEntryOptions(deploymentID, "192.168.0.0/16")
+		_, _, err := cloudDatabasesService.DeleteAllowlistEntry(deleteAllowlistEntryOptions)
+		Expect(err).To(BeNil())
+	})
+})
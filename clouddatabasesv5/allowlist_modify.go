@@ -0,0 +1,188 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ModifyAllowlistMaxAttempts is the number of times ModifyAllowlist tries to replace the allowlist before giving up
+// when other clients keep changing it.
+const ModifyAllowlistMaxAttempts = 5
+
+// Delays between the attempts of ModifyAllowlist: the first retry waits ModifyAllowlistBackoff, and the delay doubles
+// with each further retry up to ModifyAllowlistMaxBackoff.
+const (
+	ModifyAllowlistBackoff    = 50 * time.Millisecond
+	ModifyAllowlistMaxBackoff = time.Second
+)
+
+// ModifyAllowlist : Edit the allowlist of a deployment without overwriting concurrent changes
+// Gets the allowlist and its ETag, passes a copy of the entries to modify, and replaces the allowlist with the returned
+// entries using SetAllowlist with an If-Match header. If another client changed the allowlist in the meantime the
+// service answers 412 Precondition Failed, and the whole cycle is repeated with the fresh allowlist after a backoff
+// delay, up to ModifyAllowlistMaxAttempts times. The modify function may therefore be called more than once and should
+// not have side effects; it may edit the entries it is passed in place. When modify returns the entries unchanged, no
+// request is sent and the result is nil.
+func (cloudDatabases *CloudDatabasesV5) ModifyAllowlist(ctx context.Context, id string, modify func(entries []AllowlistEntry) []AllowlistEntry) (result *SetAllowlistResponse, response *core.DetailedResponse, err error) {
+	return modifyAllowlist(ctx, cloudDatabases, id, modify)
+}
+
+// ModifyAllowlistAndWait : Edit the allowlist of a deployment without overwriting concurrent changes, then wait for
+// the resulting task
+// Invokes ModifyAllowlist and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. The task is nil when the allowlist was left unchanged.
+func (cloudDatabases *CloudDatabasesV5) ModifyAllowlistAndWait(ctx context.Context, id string, modify func(entries []AllowlistEntry) []AllowlistEntry, waitForTaskOptions *WaitForTaskOptions) (result *SetAllowlistResponse, task *Task, response *core.DetailedResponse, err error) {
+	return modifyAllowlistAndWait(ctx, cloudDatabases, id, modify, waitForTaskOptions)
+}
+
+// ModifyAllowlist : Edit the allowlist of a deployment without overwriting concurrent changes
+// See CloudDatabasesV5.ModifyAllowlist.
+func (multiRegion *MultiRegionClient) ModifyAllowlist(ctx context.Context, id string, modify func(entries []AllowlistEntry) []AllowlistEntry) (result *SetAllowlistResponse, response *core.DetailedResponse, err error) {
+	return modifyAllowlist(ctx, multiRegion, id, modify)
+}
+
+// ModifyAllowlistAndWait : Edit the allowlist of a deployment without overwriting concurrent changes, then wait for
+// the resulting task
+// See CloudDatabasesV5.ModifyAllowlistAndWait.
+func (multiRegion *MultiRegionClient) ModifyAllowlistAndWait(ctx context.Context, id string, modify func(entries []AllowlistEntry) []AllowlistEntry, waitForTaskOptions *WaitForTaskOptions) (result *SetAllowlistResponse, task *Task, response *core.DetailedResponse, err error) {
+	return modifyAllowlistAndWait(ctx, multiRegion, id, modify, waitForTaskOptions)
+}
+
+// ModifyAllowlist : Edit the allowlist of a deployment without overwriting concurrent changes
+// See CloudDatabasesV5.ModifyAllowlist. The allowlist is set with SetAllowlist of the GuardedClient.
+func (guarded *GuardedClient) ModifyAllowlist(ctx context.Context, id string, modify func(entries []AllowlistEntry) []AllowlistEntry) (result *SetAllowlistResponse, response *core.DetailedResponse, err error) {
+	return modifyAllowlist(ctx, guarded, id, modify)
+}
+
+// ModifyAllowlistAndWait : Edit the allowlist of a deployment without overwriting concurrent changes, then wait for
+// the resulting task
+// See CloudDatabasesV5.ModifyAllowlistAndWait. The allowlist is set with SetAllowlist of the GuardedClient.
+func (guarded *GuardedClient) ModifyAllowlistAndWait(ctx context.Context, id string, modify func(entries []AllowlistEntry) []AllowlistEntry, waitForTaskOptions *WaitForTaskOptions) (result *SetAllowlistResponse, task *Task, response *core.DetailedResponse, err error) {
+	return modifyAllowlistAndWait(ctx, guarded, id, modify, waitForTaskOptions)
+}
+
+func modifyAllowlistAndWait(ctx context.Context, api CloudDatabasesAPI, id string, modify func(entries []AllowlistEntry) []AllowlistEntry, waitForTaskOptions *WaitForTaskOptions) (result *SetAllowlistResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = modifyAllowlist(ctx, api, id, modify)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, api, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+func modifyAllowlist(ctx context.Context, api CloudDatabasesAPI, id string, modify func(entries []AllowlistEntry) []AllowlistEntry) (result *SetAllowlistResponse, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(modify, "modify cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	backoff := ModifyAllowlistBackoff
+	for attempt := 1; ; attempt++ {
+		var current *GetAllowlistResponse
+		current, response, err = api.GetAllowlistWithContext(ctx, &GetAllowlistOptions{ID: core.StringPtr(id)})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-allowlist-error")
+			return
+		}
+		etag := response.GetHeaders().Get("ETag")
+		if etag == "" {
+			err = core.SDKErrorf(nil, "the allowlist was returned without an ETag", "missing-etag", common.GetComponentInfo())
+			return
+		}
+
+		var entries []AllowlistEntry
+		if current != nil {
+			entries = current.IPAddresses
+		}
+		modified := modify(copyAllowlistEntries(entries))
+		if allowlistsEqual(entries, modified) {
+			return
+		}
+
+		setAllowlistOptions := &SetAllowlistOptions{
+			ID:          core.StringPtr(id),
+			IPAddresses: modified,
+			IfMatch:     core.StringPtr(etag),
+		}
+		if setAllowlistOptions.IPAddresses == nil {
+			setAllowlistOptions.IPAddresses = []AllowlistEntry{}
+		}
+		result, response, err = api.SetAllowlistWithContext(ctx, setAllowlistOptions)
+		if err == nil || response == nil || response.StatusCode != http.StatusPreconditionFailed {
+			err = core.RepurposeSDKProblem(err, "set-allowlist-error")
+			return
+		}
+		if attempt == ModifyAllowlistMaxAttempts {
+			err = core.SDKErrorf(err, fmt.Sprintf("the allowlist kept changing during %d attempts", attempt), "allowlist-conflict", common.GetComponentInfo())
+			return
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = core.SDKErrorf(ctx.Err(), "", "allowlist-canceled", common.GetComponentInfo())
+			return
+		case <-timer.C:
+		}
+		backoff *= 2
+		if backoff > ModifyAllowlistMaxBackoff {
+			backoff = ModifyAllowlistMaxBackoff
+		}
+	}
+}
+
+// copyAllowlistEntries returns a copy of the entries that shares no memory with them.
+func copyAllowlistEntries(entries []AllowlistEntry) []AllowlistEntry {
+	if entries == nil {
+		return nil
+	}
+	copied := make([]AllowlistEntry, len(entries))
+	for i, entry := range entries {
+		if entry.Address != nil {
+			copied[i].Address = core.StringPtr(*entry.Address)
+		}
+		if entry.Description != nil {
+			copied[i].Description = core.StringPtr(*entry.Description)
+		}
+	}
+	return copied
+}
+
+// allowlistsEqual returns true if both allowlists hold the same entries in the same order.
+func allowlistsEqual(a []AllowlistEntry, b []AllowlistEntry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !reflect.DeepEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,143 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5/fake"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ModifyAllowlist`, func() {
+	const deploymentID = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/abc123:d1a2b3c4-0000-4000-8000-000000000001::"
+
+	var server *fake.Server
+	var cloudDatabasesService *clouddatabasesv5.CloudDatabasesV5
+	var waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions
+
+	office := clouddatabasesv5.AllowlistEntry{Address: core.StringPtr("192.168.0.0/16"), Description: core.StringPtr("office")}
+	vpn := clouddatabasesv5.AllowlistEntry{Address: core.StringPtr("10.0.0.0/8"), Description: core.StringPtr("vpn")}
+	ci := clouddatabasesv5.AllowlistEntry{Address: core.StringPtr("203.0.113.7"), Description: core.StringPtr("ci")}
+
+	appendEntry := func(entry clouddatabasesv5.AllowlistEntry) func([]clouddatabasesv5.AllowlistEntry) []clouddatabasesv5.AllowlistEntry {
+		return func(entries []clouddatabasesv5.AllowlistEntry) []clouddatabasesv5.AllowlistEntry {
+			return append(entries, entry)
+		}
+	}
+
+	BeforeEach(func() {
+		server = fake.NewServer(nil)
+		server.AddDeployment(clouddatabasesv5.Deployment{ID: core.StringPtr(deploymentID), Type: core.StringPtr("postgresql")})
+		server.SetAllowlist(deploymentID, []clouddatabasesv5.AllowlistEntry{office})
+
+		var err error
+		cloudDatabasesService, err = clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		waitForTaskOptions = cloudDatabasesService.NewWaitForTaskOptions().SetPollInterval(time.Millisecond)
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Apply the modification to the current allowlist`, func() {
+		result, task, _, err := cloudDatabasesService.ModifyAllowlistAndWait(context.Background(), deploymentID, appendEntry(vpn), waitForTaskOptions)
+		Expect(err).To(BeNil())
+		Expect(result.Task).ToNot(BeNil())
+		Expect(*task.Status).To(Equal(clouddatabasesv5.TaskStatusCompletedConst))
+		Expect(server.Allowlist(deploymentID)).To(Equal([]clouddatabasesv5.AllowlistEntry{office, vpn}))
+	})
+	It(`Apply the modification through a GuardedClient`, func() {
+		guarded := clouddatabasesv5.NewGuardedClient(cloudDatabasesService)
+		_, task, _, err := guarded.ModifyAllowlistAndWait(context.Background(), deploymentID, appendEntry(ci), waitForTaskOptions)
+		Expect(err).To(BeNil())
+		Expect(*task.Status).To(Equal(clouddatabasesv5.TaskStatusCompletedConst))
+		Expect(server.Allowlist(deploymentID)).To(Equal([]clouddatabasesv5.AllowlistEntry{office, ci}))
+	})
+	It(`Retry when another client changed the allowlist`, func() {
+		calls := 0
+		_, _, _, err := cloudDatabasesService.ModifyAllowlistAndWait(context.Background(), deploymentID, func(entries []clouddatabasesv5.AllowlistEntry) []clouddatabasesv5.AllowlistEntry {
+			calls++
+			if calls == 1 {
+				// Another automation job adds its entry between our read and our write.
+				server.SetAllowlist(deploymentID, append(entries, ci))
+			}
+			return append(entries, vpn)
+		}, waitForTaskOptions)
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal(2))
+		Expect(server.Allowlist(deploymentID)).To(Equal([]clouddatabasesv5.AllowlistEntry{office, ci, vpn}))
+	})
+	It(`Give up when the allowlist keeps changing`, func() {
+		calls := 0
+		result, response, err := cloudDatabasesService.ModifyAllowlist(context.Background(), deploymentID, func(entries []clouddatabasesv5.AllowlistEntry) []clouddatabasesv5.AllowlistEntry {
+			calls++
+			server.SetAllowlist(deploymentID, entries)
+			return append(entries, vpn)
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(result).To(BeNil())
+		Expect(response.StatusCode).To(Equal(412))
+		Expect(calls).To(Equal(clouddatabasesv5.ModifyAllowlistMaxAttempts))
+		Expect(server.Allowlist(deploymentID)).To(Equal([]clouddatabasesv5.AllowlistEntry{office}))
+	})
+	It(`Wait between attempts and stop when the context ends`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		calls := 0
+		_, _, err := cloudDatabasesService.ModifyAllowlist(ctx, deploymentID, func(entries []clouddatabasesv5.AllowlistEntry) []clouddatabasesv5.AllowlistEntry {
+			calls++
+			server.SetAllowlist(deploymentID, entries)
+			time.AfterFunc(clouddatabasesv5.ModifyAllowlistBackoff/5, cancel)
+			return append(entries, vpn)
+		})
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		Expect(calls).To(Equal(1))
+	})
+	It(`Detect entries edited in place`, func() {
+		result, _, _, err := cloudDatabasesService.ModifyAllowlistAndWait(context.Background(), deploymentID, func(entries []clouddatabasesv5.AllowlistEntry) []clouddatabasesv5.AllowlistEntry {
+			*entries[0].Description = "head office"
+			return entries
+		}, waitForTaskOptions)
+		Expect(err).To(BeNil())
+		Expect(result).ToNot(BeNil())
+		Expect(server.Allowlist(deploymentID)).To(Equal([]clouddatabasesv5.AllowlistEntry{
+			{Address: office.Address, Description: core.StringPtr("head office")},
+		}))
+	})
+	It(`Send nothing when the allowlist is unchanged`, func() {
+		result, task, _, err := cloudDatabasesService.ModifyAllowlistAndWait(context.Background(), deploymentID, func(entries []clouddatabasesv5.AllowlistEntry) []clouddatabasesv5.AllowlistEntry {
+			return entries
+		}, waitForTaskOptions)
+		Expect(err).To(BeNil())
+		Expect(result).To(BeNil())
+		Expect(task).To(BeNil())
+		Expect(server.Tasks(deploymentID)).To(BeEmpty())
+	})
+	It(`Return an error for a nil modify function`, func() {
+		_, _, err := cloudDatabasesService.ModifyAllowlist(context.Background(), deploymentID, nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,225 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// MaxAllowlistEntries is the largest number of entries the service accepts in the allowlist of a deployment.
+// Source: the allowlisting topic of the IBM Cloud Databases documentation. The API definition this client is
+// generated from does not state the limit.
+const MaxAllowlistEntries = 100
+
+// ErrInvalidAllowlist is wrapped by every error returned for an invalid allowlist entry, for an entry that duplicates
+// another entry or lies inside its range, or for an allowlist that is too long; use errors.Is to detect it.
+var ErrInvalidAllowlist = errors.New("invalid allowlist")
+
+// NewAllowlistEntry : Instantiate AllowlistEntry (Generic Model Constructor)
+// The address is validated and normalized, see NormalizeAllowlistAddress.
+func (*CloudDatabasesV5) NewAllowlistEntry(address string) (_model *AllowlistEntry, err error) {
+	normalized, err := NormalizeAllowlistAddress(address)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "model-invalid-address")
+		return
+	}
+	_model = &AllowlistEntry{
+		Address: core.StringPtr(normalized),
+	}
+	return
+}
+
+// NormalizeAllowlistAddress parses an IPv4 or IPv6 address, or a CIDR range, and returns its canonical form. The host
+// bits of a range are cleared ("10.1.2.3/8" becomes "10.0.0.0/8") and a range holding a single address becomes that
+// address ("10.0.0.1/32" becomes "10.0.0.1").
+func NormalizeAllowlistAddress(address string) (string, error) {
+	ipNet, err := parseAllowlistAddress(address)
+	if err != nil {
+		return "", core.SDKErrorf(err, "", "invalid-allowlist-address", common.GetComponentInfo())
+	}
+	return formatAllowlistNet(ipNet), nil
+}
+
+// Validate returns an error if the address of the entry is missing or is not an IP address or CIDR range.
+func (allowlistEntry *AllowlistEntry) Validate() error {
+	_, err := parseAllowlistAddress(core.StringNilMapper(allowlistEntry.Address))
+	if err != nil {
+		return core.SDKErrorf(err, "", "invalid-allowlist-address", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// AllowlistNormalization : The result of NormalizeAllowlist.
+type AllowlistNormalization struct {
+	// The normalized entries, in their original order.
+	Entries []AllowlistEntry
+
+	// Entries that were dropped because an earlier entry has the same normalized address.
+	Duplicates []AllowlistEntry
+
+	// Entries that were dropped because their range is inside the range of another entry.
+	Covered []AllowlistEntry
+}
+
+// NormalizeAllowlist validates and normalizes every address of an allowlist, drops duplicate entries and merges
+// overlapping ranges by dropping the entries whose range lies inside another. It returns an error if an address is
+// invalid or if the normalized allowlist has more than MaxAllowlistEntries entries.
+//
+// SetAllowlist normalizes the addresses it sends but does not merge entries itself: it rejects an allowlist with
+// duplicate or covered entries. Pass the normalized entries to SetAllowlist to merge them.
+func NormalizeAllowlist(entries []AllowlistEntry) (*AllowlistNormalization, error) {
+	normalization := &AllowlistNormalization{}
+
+	var nets []*net.IPNet
+	var unique []AllowlistEntry
+	seen := map[string]bool{}
+	for i, entry := range entries {
+		ipNet, err := parseAllowlistAddress(core.StringNilMapper(entry.Address))
+		if err != nil {
+			err = fmt.Errorf("entry %d: %w", i, err)
+			return nil, core.SDKErrorf(err, "", "invalid-allowlist-address", common.GetComponentInfo())
+		}
+		address := formatAllowlistNet(ipNet)
+		if seen[address] {
+			normalization.Duplicates = append(normalization.Duplicates, entry)
+			continue
+		}
+		seen[address] = true
+		entry.Address = core.StringPtr(address)
+		unique = append(unique, entry)
+		nets = append(nets, ipNet)
+	}
+
+	for i, entry := range unique {
+		covered := false
+		for j, other := range nets {
+			if i != j && netContains(other, nets[i]) {
+				covered = true
+				break
+			}
+		}
+		if covered {
+			normalization.Covered = append(normalization.Covered, entry)
+		} else {
+			normalization.Entries = append(normalization.Entries, entry)
+		}
+	}
+
+	if len(normalization.Entries) > MaxAllowlistEntries {
+		err := fmt.Errorf("%w: %d entries, at most %d are allowed", ErrInvalidAllowlist, len(normalization.Entries), MaxAllowlistEntries)
+		return nil, core.SDKErrorf(err, "", "allowlist-too-long", common.GetComponentInfo())
+	}
+	return normalization, nil
+}
+
+// normalizedIPAddresses returns the allowlist to send with its addresses normalized, or an error if an entry is
+// invalid, has the same address as another entry or lies inside the range of another entry, or if the allowlist has
+// more than MaxAllowlistEntries entries. The options are left as they are.
+func (setAllowlistOptions *SetAllowlistOptions) normalizedIPAddresses() ([]AllowlistEntry, error) {
+	if setAllowlistOptions.IPAddresses == nil {
+		return nil, nil
+	}
+	normalization, err := NormalizeAllowlist(setAllowlistOptions.IPAddresses)
+	if err != nil {
+		return nil, err
+	}
+	if len(normalization.Duplicates) > 0 {
+		err = fmt.Errorf("%w: duplicate entries for %s", ErrInvalidAllowlist, allowlistAddresses(normalization.Duplicates))
+		return nil, core.SDKErrorf(err, "", "duplicate-allowlist-entry", common.GetComponentInfo())
+	}
+	if len(normalization.Covered) > 0 {
+		err = fmt.Errorf("%w: entries for %s lie inside the range of another entry", ErrInvalidAllowlist, allowlistAddresses(normalization.Covered))
+		return nil, core.SDKErrorf(err, "", "covered-allowlist-entry", common.GetComponentInfo())
+	}
+	if normalization.Entries == nil {
+		return []AllowlistEntry{}, nil
+	}
+	return normalization.Entries, nil
+}
+
+// normalizedIPAddress returns the entry to send with its address normalized, or an error if the address is invalid.
+// The options are left as they are.
+func (addAllowlistEntryOptions *AddAllowlistEntryOptions) normalizedIPAddress() (*AllowlistEntry, error) {
+	if addAllowlistEntryOptions.IPAddress == nil {
+		return nil, nil
+	}
+	address, err := NormalizeAllowlistAddress(core.StringNilMapper(addAllowlistEntryOptions.IPAddress.Address))
+	if err != nil {
+		return nil, err
+	}
+	entry := *addAllowlistEntryOptions.IPAddress
+	entry.Address = core.StringPtr(address)
+	return &entry, nil
+}
+
+// allowlistAddresses returns the addresses of the entries, separated by commas.
+func allowlistAddresses(entries []AllowlistEntry) string {
+	var addresses []string
+	for _, entry := range entries {
+		addresses = append(addresses, core.StringNilMapper(entry.Address))
+	}
+	return strings.Join(addresses, ", ")
+}
+
+func parseAllowlistAddress(address string) (*net.IPNet, error) {
+	if address == "" {
+		return nil, allowlistAddressError(address, "missing address")
+	}
+	if strings.Contains(address, "/") {
+		ip, ipNet, err := net.ParseCIDR(address)
+		if err != nil {
+			return nil, allowlistAddressError(address, "not a CIDR range")
+		}
+		if ip.To4() != nil {
+			ipNet.IP = ipNet.IP.To4()
+		}
+		return ipNet, nil
+	}
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return nil, allowlistAddressError(address, "not an IP address")
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+}
+
+func formatAllowlistNet(ipNet *net.IPNet) string {
+	ones, bits := ipNet.Mask.Size()
+	if ones == bits {
+		return ipNet.IP.String()
+	}
+	return ipNet.String()
+}
+
+// netContains returns true if inner lies entirely inside outer.
+func netContains(outer *net.IPNet, inner *net.IPNet) bool {
+	outerOnes, outerBits := outer.Mask.Size()
+	innerOnes, innerBits := inner.Mask.Size()
+	return outerBits == innerBits && outerOnes <= innerOnes && outer.Contains(inner.IP)
+}
+
+func allowlistAddressError(address string, reason string) error {
+	return fmt.Errorf("%w: '%s': %s", ErrInvalidAllowlist, address, reason)
+}
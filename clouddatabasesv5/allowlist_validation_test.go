@@ -0,0 +1,179 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Allowlist validation`, func() {
+	Describe(`NormalizeAllowlistAddress`, func() {
+		It(`Canonicalize addresses and ranges`, func() {
+			normalized := map[string]string{
+				"10.1.2.3":            "10.1.2.3",
+				"10.1.2.3/8":          "10.0.0.0/8",
+				"10.0.0.1/32":         "10.0.0.1",
+				"::ffff:192.0.2.1":    "192.0.2.1",
+				"2001:DB8::1":         "2001:db8::1",
+				"2001:db8:0:0:1::/64": "2001:db8::/64",
+				"2001:db8::1/128":     "2001:db8::1",
+			}
+			for address, expected := range normalized {
+				actual, err := clouddatabasesv5.NormalizeAllowlistAddress(address)
+				Expect(err).To(BeNil())
+				Expect(actual).To(Equal(expected), address)
+			}
+		})
+		It(`Reject invalid addresses`, func() {
+			for _, address := range []string{"", "testString", "10.0.0.256", "10.0.0.0/33", "10.0.0.0/", "host.example.com"} {
+				_, err := clouddatabasesv5.NormalizeAllowlistAddress(address)
+				Expect(errors.Is(err, clouddatabasesv5.ErrInvalidAllowlist)).To(BeTrue(), address)
+
+				entry := &clouddatabasesv5.AllowlistEntry{Address: core.StringPtr(address)}
+				Expect(entry.Validate()).ToNot(BeNil())
+			}
+		})
+	})
+	Describe(`NewAllowlistEntry`, func() {
+		It(`Normalize the address`, func() {
+			entry, err := (*clouddatabasesv5.CloudDatabasesV5)(nil).NewAllowlistEntry("192.168.7.1/16")
+			Expect(err).To(BeNil())
+			Expect(*entry.Address).To(Equal("192.168.0.0/16"))
+
+			entry, err = (*clouddatabasesv5.CloudDatabasesV5)(nil).NewAllowlistEntry("192.168.7")
+			Expect(entry).To(BeNil())
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`NormalizeAllowlist`, func() {
+		It(`Drop duplicates and merge covered ranges`, func() {
+			normalization, err := clouddatabasesv5.NormalizeAllowlist([]clouddatabasesv5.AllowlistEntry{
+				{Address: core.StringPtr("10.20.0.0/16"), Description: core.StringPtr("team")},
+				{Address: core.StringPtr("192.0.2.1"), Description: core.StringPtr("ci")},
+				{Address: core.StringPtr("10.0.0.0/8"), Description: core.StringPtr("vpn")},
+				{Address: core.StringPtr("192.0.2.1/32"), Description: core.StringPtr("ci again")},
+				{Address: core.StringPtr("10.1.2.3/8"), Description: core.StringPtr("vpn again")},
+			})
+			Expect(err).To(BeNil())
+			Expect(normalization.Entries).To(Equal([]clouddatabasesv5.AllowlistEntry{
+				{Address: core.StringPtr("192.0.2.1"), Description: core.StringPtr("ci")},
+				{Address: core.StringPtr("10.0.0.0/8"), Description: core.StringPtr("vpn")},
+			}))
+			Expect(normalization.Duplicates).To(HaveLen(2))
+			Expect(*normalization.Duplicates[0].Description).To(Equal("ci again"))
+			Expect(normalization.Covered).To(HaveLen(1))
+			Expect(*normalization.Covered[0].Description).To(Equal("team"))
+		})
+		It(`Enforce the entry count limit`, func() {
+			var entries []clouddatabasesv5.AllowlistEntry
+			for i := 0; i <= clouddatabasesv5.MaxAllowlistEntries; i++ {
+				entries = append(entries, clouddatabasesv5.AllowlistEntry{Address: core.StringPtr(fmt.Sprintf("10.0.%d.%d", i/256, i%256))})
+			}
+			_, err := clouddatabasesv5.NormalizeAllowlist(entries)
+			Expect(errors.Is(err, clouddatabasesv5.ErrInvalidAllowlist)).To(BeTrue())
+			_, err = clouddatabasesv5.NormalizeAllowlist(entries[1:])
+			Expect(err).To(BeNil())
+		})
+	})
+	Describe(`SetAllowlist and AddAllowlistEntry`, func() {
+		var testServer *httptest.Server
+		var cloudDatabasesService *clouddatabasesv5.CloudDatabasesV5
+		var requestBodies []string
+
+		BeforeEach(func() {
+			requestBodies = nil
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				body, _ := io.ReadAll(req.Body)
+				requestBodies = append(requestBodies, strings.TrimSpace(string(body)))
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(202)
+				fmt.Fprint(res, `{"task": {"id": "task-id"}}`)
+			}))
+			var serviceErr error
+			cloudDatabasesService, serviceErr = clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Send the normalized allowlist`, func() {
+			setAllowlistOptions := cloudDatabasesService.NewSetAllowlistOptions("testString")
+			setAllowlistOptions.SetIPAddresses([]clouddatabasesv5.AllowlistEntry{
+				{Address: core.StringPtr("10.1.2.3/8")},
+				{Address: core.StringPtr("192.0.2.1")},
+			})
+			_, _, err := cloudDatabasesService.SetAllowlist(setAllowlistOptions)
+			Expect(err).To(BeNil())
+			Expect(requestBodies).To(Equal([]string{`{"ip_addresses":[{"address":"10.0.0.0/8"},{"address":"192.0.2.1"}]}`}))
+			Expect(*setAllowlistOptions.IPAddresses[0].Address).To(Equal("10.1.2.3/8"))
+
+			addAllowlistEntryOptions := cloudDatabasesService.NewAddAllowlistEntryOptions("testString")
+			addAllowlistEntryOptions.SetIPAddress(&clouddatabasesv5.AllowlistEntry{Address: core.StringPtr("2001:DB8::1/128")})
+			_, _, err = cloudDatabasesService.AddAllowlistEntry(addAllowlistEntryOptions)
+			Expect(err).To(BeNil())
+			Expect(requestBodies[1]).To(Equal(`{"ip_address":{"address":"2001:db8::1"}}`))
+		})
+		It(`Reject covered entries instead of merging them`, func() {
+			entries := []clouddatabasesv5.AllowlistEntry{
+				{Address: core.StringPtr("10.1.2.3/8")},
+				{Address: core.StringPtr("10.20.0.0/16")},
+				{Address: core.StringPtr("10.30.0.1")},
+			}
+			setAllowlistOptions := cloudDatabasesService.NewSetAllowlistOptions("testString").SetIPAddresses(entries)
+			_, _, err := cloudDatabasesService.SetAllowlist(setAllowlistOptions)
+			Expect(errors.Is(err, clouddatabasesv5.ErrInvalidAllowlist)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("10.20.0.0/16, 10.30.0.1 lie inside the range of another entry"))
+			Expect(requestBodies).To(BeEmpty())
+
+			// Merging is explicit.
+			normalization, err := clouddatabasesv5.NormalizeAllowlist(entries)
+			Expect(err).To(BeNil())
+			_, _, err = cloudDatabasesService.SetAllowlist(setAllowlistOptions.SetIPAddresses(normalization.Entries))
+			Expect(err).To(BeNil())
+			Expect(requestBodies).To(Equal([]string{`{"ip_addresses":[{"address":"10.0.0.0/8"}]}`}))
+		})
+		It(`Reject invalid and duplicate entries without sending a request`, func() {
+			setAllowlistOptions := cloudDatabasesService.NewSetAllowlistOptions("testString")
+			setAllowlistOptions.SetIPAddresses([]clouddatabasesv5.AllowlistEntry{
+				{Address: core.StringPtr("10.0.0.1")},
+				{Address: core.StringPtr("10.0.0.1/32")},
+			})
+			_, _, err := cloudDatabasesService.SetAllowlist(setAllowlistOptions)
+			Expect(errors.Is(err, clouddatabasesv5.ErrInvalidAllowlist)).To(BeTrue())
+
+			addAllowlistEntryOptions := cloudDatabasesService.NewAddAllowlistEntryOptions("testString")
+			addAllowlistEntryOptions.SetIPAddress(&clouddatabasesv5.AllowlistEntry{Address: core.StringPtr("10.0.0.300")})
+			_, _, err = cloudDatabasesService.AddAllowlistEntry(addAllowlistEntryOptions)
+			Expect(errors.Is(err, clouddatabasesv5.ErrInvalidAllowlist)).To(BeTrue())
+			Expect(requestBodies).To(BeEmpty())
+		})
+	})
+})
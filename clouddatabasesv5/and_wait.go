@@ -0,0 +1,256 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CreateDatabaseUserAndWait : Creates a user based on user type, then wait for the resulting task
+// Invokes CreateDatabaseUserWithContext and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. Both the operation result and the final state of the task are returned.
+func (cloudDatabases *CloudDatabasesV5) CreateDatabaseUserAndWait(ctx context.Context, createDatabaseUserOptions *CreateDatabaseUserOptions, waitForTaskOptions *WaitForTaskOptions) (result *CreateDatabaseUserResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.CreateDatabaseUserWithContext(ctx, createDatabaseUserOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, cloudDatabases, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateUserAndWait : Update a user's password or role, then wait for the resulting task
+// Invokes UpdateUserWithContext and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. Both the operation result and the final state of the task are returned.
+func (cloudDatabases *CloudDatabasesV5) UpdateUserAndWait(ctx context.Context, updateUserOptions *UpdateUserOptions, waitForTaskOptions *WaitForTaskOptions) (result *UpdateUserResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.UpdateUserWithContext(ctx, updateUserOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, cloudDatabases, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteDatabaseUserAndWait : Deletes a user based on user type, then wait for the resulting task
+// Invokes DeleteDatabaseUserWithContext and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. Both the operation result and the final state of the task are returned.
+func (cloudDatabases *CloudDatabasesV5) DeleteDatabaseUserAndWait(ctx context.Context, deleteDatabaseUserOptions *DeleteDatabaseUserOptions, waitForTaskOptions *WaitForTaskOptions) (result *DeleteDatabaseUserResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.DeleteDatabaseUserWithContext(ctx, deleteDatabaseUserOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, cloudDatabases, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateDatabaseConfigurationAndWait : Change your database configuration, then wait for the resulting task
+// Invokes UpdateDatabaseConfigurationWithContext and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. Both the operation result and the final state of the task are returned.
+func (cloudDatabases *CloudDatabasesV5) UpdateDatabaseConfigurationAndWait(ctx context.Context, updateDatabaseConfigurationOptions *UpdateDatabaseConfigurationOptions, waitForTaskOptions *WaitForTaskOptions) (result *UpdateDatabaseConfigurationResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.UpdateDatabaseConfigurationWithContext(ctx, updateDatabaseConfigurationOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, cloudDatabases, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ResyncReplicaAndWait : Resync read-only replica, then wait for the resulting task
+// Invokes ResyncReplicaWithContext and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. Both the operation result and the final state of the task are returned.
+func (cloudDatabases *CloudDatabasesV5) ResyncReplicaAndWait(ctx context.Context, resyncReplicaOptions *ResyncReplicaOptions, waitForTaskOptions *WaitForTaskOptions) (result *ResyncReplicaResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.ResyncReplicaWithContext(ctx, resyncReplicaOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, cloudDatabases, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PromoteReadOnlyReplicaAndWait : Promote read-only replica to a full deployment, then wait for the resulting task
+// Invokes PromoteReadOnlyReplicaWithContext and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. Both the operation result and the final state of the task are returned.
+func (cloudDatabases *CloudDatabasesV5) PromoteReadOnlyReplicaAndWait(ctx context.Context, promoteReadOnlyReplicaOptions *PromoteReadOnlyReplicaOptions, waitForTaskOptions *WaitForTaskOptions) (result *PromoteReadOnlyReplicaResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.PromoteReadOnlyReplicaWithContext(ctx, promoteReadOnlyReplicaOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, cloudDatabases, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// StartOndemandBackupAndWait : Initiate an on-demand backup, then wait for the resulting task
+// Invokes StartOndemandBackupWithContext and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. Both the operation result and the final state of the task are returned.
+func (cloudDatabases *CloudDatabasesV5) StartOndemandBackupAndWait(ctx context.Context, startOndemandBackupOptions *StartOndemandBackupOptions, waitForTaskOptions *WaitForTaskOptions) (result *StartOndemandBackupResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.StartOndemandBackupWithContext(ctx, startOndemandBackupOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, cloudDatabases, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SetDeploymentScalingGroupAndWait : Set scaling values on a specified group, then wait for the resulting task
+// Invokes SetDeploymentScalingGroupWithContext and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. Both the operation result and the final state of the task are returned.
+func (cloudDatabases *CloudDatabasesV5) SetDeploymentScalingGroupAndWait(ctx context.Context, setDeploymentScalingGroupOptions *SetDeploymentScalingGroupOptions, waitForTaskOptions *WaitForTaskOptions) (result *SetDeploymentScalingGroupResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.SetDeploymentScalingGroupWithContext(ctx, setDeploymentScalingGroupOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, cloudDatabases, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SetAutoscalingConditionsAndWait : Set the autoscaling configuration from a deployment, then wait for the resulting task
+// Invokes SetAutoscalingConditionsWithContext and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. Both the operation result and the final state of the task are returned.
+func (cloudDatabases *CloudDatabasesV5) SetAutoscalingConditionsAndWait(ctx context.Context, setAutoscalingConditionsOptions *SetAutoscalingConditionsOptions, waitForTaskOptions *WaitForTaskOptions) (result *SetAutoscalingConditionsResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.SetAutoscalingConditionsWithContext(ctx, setAutoscalingConditionsOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, cloudDatabases, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// KillConnectionsAndWait : Kill connections to a PostgreSQL or EnterpriseDB deployment, then wait for the resulting task
+// Invokes KillConnectionsWithContext and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. Both the operation result and the final state of the task are returned.
+func (cloudDatabases *CloudDatabasesV5) KillConnectionsAndWait(ctx context.Context, killConnectionsOptions *KillConnectionsOptions, waitForTaskOptions *WaitForTaskOptions) (result *KillConnectionsResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.KillConnectionsWithContext(ctx, killConnectionsOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, cloudDatabases, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateLogicalReplicationSlotAndWait : Create a new logical replication slot, then wait for the resulting task
+// Invokes CreateLogicalReplicationSlotWithContext and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. Both the operation result and the final state of the task are returned.
+func (cloudDatabases *CloudDatabasesV5) CreateLogicalReplicationSlotAndWait(ctx context.Context, createLogicalReplicationSlotOptions *CreateLogicalReplicationSlotOptions, waitForTaskOptions *WaitForTaskOptions) (result *CreateLogicalReplicationSlotResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.CreateLogicalReplicationSlotWithContext(ctx, createLogicalReplicationSlotOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, cloudDatabases, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteLogicalReplicationSlotAndWait : Delete a logical replication slot, then wait for the resulting task
+// Invokes DeleteLogicalReplicationSlotWithContext and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. Both the operation result and the final state of the task are returned.
+func (cloudDatabases *CloudDatabasesV5) DeleteLogicalReplicationSlotAndWait(ctx context.Context, deleteLogicalReplicationSlotOptions *DeleteLogicalReplicationSlotOptions, waitForTaskOptions *WaitForTaskOptions) (result *DeleteLogicalReplicationSlotResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.DeleteLogicalReplicationSlotWithContext(ctx, deleteLogicalReplicationSlotOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, cloudDatabases, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SetAllowlistAndWait : Set the allowlist for a deployment, then wait for the resulting task
+// Invokes SetAllowlistWithContext and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. Both the operation result and the final state of the task are returned.
+func (cloudDatabases *CloudDatabasesV5) SetAllowlistAndWait(ctx context.Context, setAllowlistOptions *SetAllowlistOptions, waitForTaskOptions *WaitForTaskOptions) (result *SetAllowlistResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.SetAllowlistWithContext(ctx, setAllowlistOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, cloudDatabases, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// AddAllowlistEntryAndWait : Add an address or range to the allowlist for a deployment, then wait for the resulting task
+// Invokes AddAllowlistEntryWithContext and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. Both the operation result and the final state of the task are returned.
+func (cloudDatabases *CloudDatabasesV5) AddAllowlistEntryAndWait(ctx context.Context, addAllowlistEntryOptions *AddAllowlistEntryOptions, waitForTaskOptions *WaitForTaskOptions) (result *AddAllowlistEntryResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.AddAllowlistEntryWithContext(ctx, addAllowlistEntryOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, cloudDatabases, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteAllowlistEntryAndWait : Delete an address or range from the allowlist of a deployment, then wait for the resulting task
+// Invokes DeleteAllowlistEntryWithContext and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. Both the operation result and the final state of the task are returned.
+func (cloudDatabases *CloudDatabasesV5) DeleteAllowlistEntryAndWait(ctx context.Context, deleteAllowlistEntryOptions *DeleteAllowlistEntryOptions, waitForTaskOptions *WaitForTaskOptions) (result *DeleteAllowlistEntryResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.DeleteAllowlistEntryWithContext(ctx, deleteAllowlistEntryOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, cloudDatabases, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SetDatabaseInplaceVersionUpgradeAndWait : Upgrade your database version, then wait for the resulting task
+// Invokes SetDatabaseInplaceVersionUpgradeWithContext and waits for the returned task to reach a terminal status, using WaitForTask with the
+// specified options. Both the operation result and the final state of the task are returned.
+func (cloudDatabases *CloudDatabasesV5) SetDatabaseInplaceVersionUpgradeAndWait(ctx context.Context, setDatabaseInplaceVersionUpgradeOptions *SetDatabaseInplaceVersionUpgradeOptions, waitForTaskOptions *WaitForTaskOptions) (result *SetDatabaseInplaceVersionUpgradeResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.SetDatabaseInplaceVersionUpgradeWithContext(ctx, setDatabaseInplaceVersionUpgradeOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, cloudDatabases, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// waitForResultTask waits for the task returned by an operation. Operations that did not return a task leave
+// nothing to wait for, so the (nil) task is returned as-is.
+func waitForResultTask(ctx context.Context, api CloudDatabasesAPI, task *Task, waitForTaskOptions *WaitForTaskOptions) (*Task, error) {
+	if task == nil || task.ID == nil {
+		return task, nil
+	}
+	return api.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+}
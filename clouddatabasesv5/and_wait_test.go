@@ -0,0 +1,123 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AndWait operations`, func() {
+	var testServer *httptest.Server
+	var taskStatus string
+	var getTaskCalls int
+
+	BeforeEach(func() {
+		taskStatus = clouddatabasesv5.TaskStatusCompletedConst
+		getTaskCalls = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/deployments/testString/allowlists/ip_addresses":
+				Expect(req.Method).To(Equal("PUT"))
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"task": {"id": "allowlistTask", "status": "queued"}}`)
+			case "/deployments/testString/users/database/user":
+				Expect(req.Method).To(Equal("PATCH"))
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{}`)
+			case "/tasks/allowlistTask":
+				getTaskCalls++
+				status := clouddatabasesv5.TaskStatusRunningConst
+				if getTaskCalls > 1 {
+					status = taskStatus
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"task": {"id": "allowlistTask", "status": "%s"}}`, status)
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	newService := func() *clouddatabasesv5.CloudDatabasesV5 {
+		cloudDatabasesService, serviceErr := clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return cloudDatabasesService
+	}
+	waitForTaskOptions := func() *clouddatabasesv5.WaitForTaskOptions {
+		return new(clouddatabasesv5.WaitForTaskOptions).SetPollInterval(time.Millisecond)
+	}
+
+	It(`Invoke SetAllowlistAndWait successfully`, func() {
+		cloudDatabasesService := newService()
+
+		setAllowlistOptionsModel := cloudDatabasesService.NewSetAllowlistOptions("testString")
+		result, task, response, err := cloudDatabasesService.SetAllowlistAndWait(context.Background(), setAllowlistOptionsModel, waitForTaskOptions())
+		Expect(err).To(BeNil())
+		Expect(response).ToNot(BeNil())
+		Expect(*result.Task.Status).To(Equal(clouddatabasesv5.TaskStatusQueuedConst))
+		Expect(*task.Status).To(Equal(clouddatabasesv5.TaskStatusCompletedConst))
+		Expect(getTaskCalls).To(Equal(2))
+	})
+	It(`Invoke SetAllowlistAndWait with a failed task`, func() {
+		taskStatus = clouddatabasesv5.TaskStatusFailedConst
+		cloudDatabasesService := newService()
+
+		setAllowlistOptionsModel := cloudDatabasesService.NewSetAllowlistOptions("testString")
+		result, task, _, err := cloudDatabasesService.SetAllowlistAndWait(context.Background(), setAllowlistOptionsModel, waitForTaskOptions())
+		Expect(errors.Is(err, clouddatabasesv5.ErrTaskFailed)).To(BeTrue())
+		Expect(result).ToNot(BeNil())
+		Expect(*task.Status).To(Equal(clouddatabasesv5.TaskStatusFailedConst))
+	})
+	It(`Invoke UpdateUserAndWait without a returned task`, func() {
+		cloudDatabasesService := newService()
+
+		updateUserOptionsModel := cloudDatabasesService.NewUpdateUserOptions("testString", "database", "user")
+		result, task, response, err := cloudDatabasesService.UpdateUserAndWait(context.Background(), updateUserOptionsModel, nil)
+		Expect(err).To(BeNil())
+		Expect(response).ToNot(BeNil())
+		Expect(result).ToNot(BeNil())
+		Expect(task).To(BeNil())
+		Expect(getTaskCalls).To(Equal(0))
+	})
+	It(`Invoke AddAllowlistEntryAndWait with error: Operation request error`, func() {
+		cloudDatabasesService := newService()
+
+		result, task, response, err := cloudDatabasesService.AddAllowlistEntryAndWait(context.Background(), nil, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(response).To(BeNil())
+		Expect(result).To(BeNil())
+		Expect(task).To(BeNil())
+	})
+})
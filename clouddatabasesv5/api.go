@@ -0,0 +1,118 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CloudDatabasesAPI : The operations of the Cloud Databases service, as implemented by CloudDatabasesV5.
+// Code that depends on this interface rather than on *CloudDatabasesV5 can be unit tested with a stub
+// implementation, such as the one in the clouddatabasesv5/stub package. The options constructors (NewXxxOptions)
+// do not use their receiver and can be called on a nil *CloudDatabasesV5.
+type CloudDatabasesAPI interface {
+	ListDeployables(listDeployablesOptions *ListDeployablesOptions) (result *ListDeployablesResponse, response *core.DetailedResponse, err error)
+	ListDeployablesWithContext(ctx context.Context, listDeployablesOptions *ListDeployablesOptions) (result *ListDeployablesResponse, response *core.DetailedResponse, err error)
+	ListRegions(listRegionsOptions *ListRegionsOptions) (result *ListRegionsResponse, response *core.DetailedResponse, err error)
+	ListRegionsWithContext(ctx context.Context, listRegionsOptions *ListRegionsOptions) (result *ListRegionsResponse, response *core.DetailedResponse, err error)
+	GetDeploymentInfo(getDeploymentInfoOptions *GetDeploymentInfoOptions) (result *GetDeploymentInfoResponse, response *core.DetailedResponse, err error)
+	GetDeploymentInfoWithContext(ctx context.Context, getDeploymentInfoOptions *GetDeploymentInfoOptions) (result *GetDeploymentInfoResponse, response *core.DetailedResponse, err error)
+	CreateDatabaseUser(createDatabaseUserOptions *CreateDatabaseUserOptions) (result *CreateDatabaseUserResponse, response *core.DetailedResponse, err error)
+	CreateDatabaseUserWithContext(ctx context.Context, createDatabaseUserOptions *CreateDatabaseUserOptions) (result *CreateDatabaseUserResponse, response *core.DetailedResponse, err error)
+	UpdateUser(updateUserOptions *UpdateUserOptions) (result *UpdateUserResponse, response *core.DetailedResponse, err error)
+	UpdateUserWithContext(ctx context.Context, updateUserOptions *UpdateUserOptions) (result *UpdateUserResponse, response *core.DetailedResponse, err error)
+	DeleteDatabaseUser(deleteDatabaseUserOptions *DeleteDatabaseUserOptions) (result *DeleteDatabaseUserResponse, response *core.DetailedResponse, err error)
+	DeleteDatabaseUserWithContext(ctx context.Context, deleteDatabaseUserOptions *DeleteDatabaseUserOptions) (result *DeleteDatabaseUserResponse, response *core.DetailedResponse, err error)
+	GetDatabaseConfigurationSchema(getDatabaseConfigurationSchemaOptions *GetDatabaseConfigurationSchemaOptions) (result *GetDatabaseConfigurationSchemaResponse, response *core.DetailedResponse, err error)
+	GetDatabaseConfigurationSchemaWithContext(ctx context.Context, getDatabaseConfigurationSchemaOptions *GetDatabaseConfigurationSchemaOptions) (result *GetDatabaseConfigurationSchemaResponse, response *core.DetailedResponse, err error)
+	UpdateDatabaseConfiguration(updateDatabaseConfigurationOptions *UpdateDatabaseConfigurationOptions) (result *UpdateDatabaseConfigurationResponse, response *core.DetailedResponse, err error)
+	UpdateDatabaseConfigurationWithContext(ctx context.Context, updateDatabaseConfigurationOptions *UpdateDatabaseConfigurationOptions) (result *UpdateDatabaseConfigurationResponse, response *core.DetailedResponse, err error)
+	ListRemotes(listRemotesOptions *ListRemotesOptions) (result *ListRemotesResponse, response *core.DetailedResponse, err error)
+	ListRemotesWithContext(ctx context.Context, listRemotesOptions *ListRemotesOptions) (result *ListRemotesResponse, response *core.DetailedResponse, err error)
+	ResyncReplica(resyncReplicaOptions *ResyncReplicaOptions) (result *ResyncReplicaResponse, response *core.DetailedResponse, err error)
+	ResyncReplicaWithContext(ctx context.Context, resyncReplicaOptions *ResyncReplicaOptions) (result *ResyncReplicaResponse, response *core.DetailedResponse, err error)
+	PromoteReadOnlyReplica(promoteReadOnlyReplicaOptions *PromoteReadOnlyReplicaOptions) (result *PromoteReadOnlyReplicaResponse, response *core.DetailedResponse, err error)
+	PromoteReadOnlyReplicaWithContext(ctx context.Context, promoteReadOnlyReplicaOptions *PromoteReadOnlyReplicaOptions) (result *PromoteReadOnlyReplicaResponse, response *core.DetailedResponse, err error)
+	ListDeploymentTasks(listDeploymentTasksOptions *ListDeploymentTasksOptions) (result *Tasks, response *core.DetailedResponse, err error)
+	ListDeploymentTasksWithContext(ctx context.Context, listDeploymentTasksOptions *ListDeploymentTasksOptions) (result *Tasks, response *core.DetailedResponse, err error)
+	GetTask(getTaskOptions *GetTaskOptions) (result *GetTaskResponse, response *core.DetailedResponse, err error)
+	GetTaskWithContext(ctx context.Context, getTaskOptions *GetTaskOptions) (result *GetTaskResponse, response *core.DetailedResponse, err error)
+	GetBackupInfo(getBackupInfoOptions *GetBackupInfoOptions) (result *GetBackupInfoResponse, response *core.DetailedResponse, err error)
+	GetBackupInfoWithContext(ctx context.Context, getBackupInfoOptions *GetBackupInfoOptions) (result *GetBackupInfoResponse, response *core.DetailedResponse, err error)
+	ListDeploymentBackups(listDeploymentBackupsOptions *ListDeploymentBackupsOptions) (result *Backups, response *core.DetailedResponse, err error)
+	ListDeploymentBackupsWithContext(ctx context.Context, listDeploymentBackupsOptions *ListDeploymentBackupsOptions) (result *Backups, response *core.DetailedResponse, err error)
+	StartOndemandBackup(startOndemandBackupOptions *StartOndemandBackupOptions) (result *StartOndemandBackupResponse, response *core.DetailedResponse, err error)
+	StartOndemandBackupWithContext(ctx context.Context, startOndemandBackupOptions *StartOndemandBackupOptions) (result *StartOndemandBackupResponse, response *core.DetailedResponse, err error)
+	GetPitrData(getPitrDataOptions *GetPitrDataOptions) (result *GetPitrDataResponse, response *core.DetailedResponse, err error)
+	GetPitrDataWithContext(ctx context.Context, getPitrDataOptions *GetPitrDataOptions) (result *GetPitrDataResponse, response *core.DetailedResponse, err error)
+	GetConnection(getConnectionOptions *GetConnectionOptions) (result *GetConnectionResponse, response *core.DetailedResponse, err error)
+	GetConnectionWithContext(ctx context.Context, getConnectionOptions *GetConnectionOptions) (result *GetConnectionResponse, response *core.DetailedResponse, err error)
+	CompleteConnection(completeConnectionOptions *CompleteConnectionOptions) (result *CompleteConnectionResponse, response *core.DetailedResponse, err error)
+	CompleteConnectionWithContext(ctx context.Context, completeConnectionOptions *CompleteConnectionOptions) (result *CompleteConnectionResponse, response *core.DetailedResponse, err error)
+	ListDeploymentScalingGroups(listDeploymentScalingGroupsOptions *ListDeploymentScalingGroupsOptions) (result *ListDeploymentScalingGroupsResponse, response *core.DetailedResponse, err error)
+	ListDeploymentScalingGroupsWithContext(ctx context.Context, listDeploymentScalingGroupsOptions *ListDeploymentScalingGroupsOptions) (result *ListDeploymentScalingGroupsResponse, response *core.DetailedResponse, err error)
+	GetDefaultScalingGroups(getDefaultScalingGroupsOptions *GetDefaultScalingGroupsOptions) (result *GetDefaultScalingGroupsResponse, response *core.DetailedResponse, err error)
+	GetDefaultScalingGroupsWithContext(ctx context.Context, getDefaultScalingGroupsOptions *GetDefaultScalingGroupsOptions) (result *GetDefaultScalingGroupsResponse, response *core.DetailedResponse, err error)
+	SetDeploymentScalingGroup(setDeploymentScalingGroupOptions *SetDeploymentScalingGroupOptions) (result *SetDeploymentScalingGroupResponse, response *core.DetailedResponse, err error)
+	SetDeploymentScalingGroupWithContext(ctx context.Context, setDeploymentScalingGroupOptions *SetDeploymentScalingGroupOptions) (result *SetDeploymentScalingGroupResponse, response *core.DetailedResponse, err error)
+	GetAutoscalingConditions(getAutoscalingConditionsOptions *GetAutoscalingConditionsOptions) (result *AutoscalingGroup, response *core.DetailedResponse, err error)
+	GetAutoscalingConditionsWithContext(ctx context.Context, getAutoscalingConditionsOptions *GetAutoscalingConditionsOptions) (result *AutoscalingGroup, response *core.DetailedResponse, err error)
+	SetAutoscalingConditions(setAutoscalingConditionsOptions *SetAutoscalingConditionsOptions) (result *SetAutoscalingConditionsResponse, response *core.DetailedResponse, err error)
+	SetAutoscalingConditionsWithContext(ctx context.Context, setAutoscalingConditionsOptions *SetAutoscalingConditionsOptions) (result *SetAutoscalingConditionsResponse, response *core.DetailedResponse, err error)
+	KillConnections(killConnectionsOptions *KillConnectionsOptions) (result *KillConnectionsResponse, response *core.DetailedResponse, err error)
+	KillConnectionsWithContext(ctx context.Context, killConnectionsOptions *KillConnectionsOptions) (result *KillConnectionsResponse, response *core.DetailedResponse, err error)
+	CreateLogicalReplicationSlot(createLogicalReplicationSlotOptions *CreateLogicalReplicationSlotOptions) (result *CreateLogicalReplicationSlotResponse, response *core.DetailedResponse, err error)
+	CreateLogicalReplicationSlotWithContext(ctx context.Context, createLogicalReplicationSlotOptions *CreateLogicalReplicationSlotOptions) (result *CreateLogicalReplicationSlotResponse, response *core.DetailedResponse, err error)
+	DeleteLogicalReplicationSlot(deleteLogicalReplicationSlotOptions *DeleteLogicalReplicationSlotOptions) (result *DeleteLogicalReplicationSlotResponse, response *core.DetailedResponse, err error)
+	DeleteLogicalReplicationSlotWithContext(ctx context.Context, deleteLogicalReplicationSlotOptions *DeleteLogicalReplicationSlotOptions) (result *DeleteLogicalReplicationSlotResponse, response *core.DetailedResponse, err error)
+	GetAllowlist(getAllowlistOptions *GetAllowlistOptions) (result *GetAllowlistResponse, response *core.DetailedResponse, err error)
+	GetAllowlistWithContext(ctx context.Context, getAllowlistOptions *GetAllowlistOptions) (result *GetAllowlistResponse, response *core.DetailedResponse, err error)
+	SetAllowlist(setAllowlistOptions *SetAllowlistOptions) (result *SetAllowlistResponse, response *core.DetailedResponse, err error)
+	SetAllowlistWithContext(ctx context.Context, setAllowlistOptions *SetAllowlistOptions) (result *SetAllowlistResponse, response *core.DetailedResponse, err error)
+	AddAllowlistEntry(addAllowlistEntryOptions *AddAllowlistEntryOptions) (result *AddAllowlistEntryResponse, response *core.DetailedResponse, err error)
+	AddAllowlistEntryWithContext(ctx context.Context, addAllowlistEntryOptions *AddAllowlistEntryOptions) (result *AddAllowlistEntryResponse, response *core.DetailedResponse, err error)
+	DeleteAllowlistEntry(deleteAllowlistEntryOptions *DeleteAllowlistEntryOptions) (result *DeleteAllowlistEntryResponse, response *core.DetailedResponse, err error)
+	DeleteAllowlistEntryWithContext(ctx context.Context, deleteAllowlistEntryOptions *DeleteAllowlistEntryOptions) (result *DeleteAllowlistEntryResponse, response *core.DetailedResponse, err error)
+	CreateCapability(createCapabilityOptions *CreateCapabilityOptions) (result *CreateCapabilityResponse, response *core.DetailedResponse, err error)
+	CreateCapabilityWithContext(ctx context.Context, createCapabilityOptions *CreateCapabilityOptions) (result *CreateCapabilityResponse, response *core.DetailedResponse, err error)
+	GetDeploymentCapability(getDeploymentCapabilityOptions *GetDeploymentCapabilityOptions) (result *GetDeploymentCapabilityResponse, response *core.DetailedResponse, err error)
+	GetDeploymentCapabilityWithContext(ctx context.Context, getDeploymentCapabilityOptions *GetDeploymentCapabilityOptions) (result *GetDeploymentCapabilityResponse, response *core.DetailedResponse, err error)
+	SetDatabaseInplaceVersionUpgrade(setDatabaseInplaceVersionUpgradeOptions *SetDatabaseInplaceVersionUpgradeOptions) (result *SetDatabaseInplaceVersionUpgradeResponse, response *core.DetailedResponse, err error)
+	SetDatabaseInplaceVersionUpgradeWithContext(ctx context.Context, setDatabaseInplaceVersionUpgradeOptions *SetDatabaseInplaceVersionUpgradeOptions) (result *SetDatabaseInplaceVersionUpgradeResponse, response *core.DetailedResponse, err error)
+
+	WaitForTask(ctx context.Context, taskID string, waitForTaskOptions *WaitForTaskOptions) (result *Task, err error)
+	CreateDatabaseUserAndWait(ctx context.Context, createDatabaseUserOptions *CreateDatabaseUserOptions, waitForTaskOptions *WaitForTaskOptions) (result *CreateDatabaseUserResponse, task *Task, response *core.DetailedResponse, err error)
+	UpdateUserAndWait(ctx context.Context, updateUserOptions *UpdateUserOptions, waitForTaskOptions *WaitForTaskOptions) (result *UpdateUserResponse, task *Task, response *core.DetailedResponse, err error)
+	DeleteDatabaseUserAndWait(ctx context.Context, deleteDatabaseUserOptions *DeleteDatabaseUserOptions, waitForTaskOptions *WaitForTaskOptions) (result *DeleteDatabaseUserResponse, task *Task, response *core.DetailedResponse, err error)
+	UpdateDatabaseConfigurationAndWait(ctx context.Context, updateDatabaseConfigurationOptions *UpdateDatabaseConfigurationOptions, waitForTaskOptions *WaitForTaskOptions) (result *UpdateDatabaseConfigurationResponse, task *Task, response *core.DetailedResponse, err error)
+	ResyncReplicaAndWait(ctx context.Context, resyncReplicaOptions *ResyncReplicaOptions, waitForTaskOptions *WaitForTaskOptions) (result *ResyncReplicaResponse, task *Task, response *core.DetailedResponse, err error)
+	PromoteReadOnlyReplicaAndWait(ctx context.Context, promoteReadOnlyReplicaOptions *PromoteReadOnlyReplicaOptions, waitForTaskOptions *WaitForTaskOptions) (result *PromoteReadOnlyReplicaResponse, task *Task, response *core.DetailedResponse, err error)
+	StartOndemandBackupAndWait(ctx context.Context, startOndemandBackupOptions *StartOndemandBackupOptions, waitForTaskOptions *WaitForTaskOptions) (result *StartOndemandBackupResponse, task *Task, response *core.DetailedResponse, err error)
+	SetDeploymentScalingGroupAndWait(ctx context.Context, setDeploymentScalingGroupOptions *SetDeploymentScalingGroupOptions, waitForTaskOptions *WaitForTaskOptions) (result *SetDeploymentScalingGroupResponse, task *Task, response *core.DetailedResponse, err error)
+	SetAutoscalingConditionsAndWait(ctx context.Context, setAutoscalingConditionsOptions *SetAutoscalingConditionsOptions, waitForTaskOptions *WaitForTaskOptions) (result *SetAutoscalingConditionsResponse, task *Task, response *core.DetailedResponse, err error)
+	KillConnectionsAndWait(ctx context.Context, killConnectionsOptions *KillConnectionsOptions, waitForTaskOptions *WaitForTaskOptions) (result *KillConnectionsResponse, task *Task, response *core.DetailedResponse, err error)
+	CreateLogicalReplicationSlotAndWait(ctx context.Context, createLogicalReplicationSlotOptions *CreateLogicalReplicationSlotOptions, waitForTaskOptions *WaitForTaskOptions) (result *CreateLogicalReplicationSlotResponse, task *Task, response *core.DetailedResponse, err error)
+	DeleteLogicalReplicationSlotAndWait(ctx context.Context, deleteLogicalReplicationSlotOptions *DeleteLogicalReplicationSlotOptions, waitForTaskOptions *WaitForTaskOptions) (result *DeleteLogicalReplicationSlotResponse, task *Task, response *core.DetailedResponse, err error)
+	SetAllowlistAndWait(ctx context.Context, setAllowlistOptions *SetAllowlistOptions, waitForTaskOptions *WaitForTaskOptions) (result *SetAllowlistResponse, task *Task, response *core.DetailedResponse, err error)
+	AddAllowlistEntryAndWait(ctx context.Context, addAllowlistEntryOptions *AddAllowlistEntryOptions, waitForTaskOptions *WaitForTaskOptions) (result *AddAllowlistEntryResponse, task *Task, response *core.DetailedResponse, err error)
+	DeleteAllowlistEntryAndWait(ctx context.Context, deleteAllowlistEntryOptions *DeleteAllowlistEntryOptions, waitForTaskOptions *WaitForTaskOptions) (result *DeleteAllowlistEntryResponse, task *Task, response *core.DetailedResponse, err error)
+	SetDatabaseInplaceVersionUpgradeAndWait(ctx context.Context, setDatabaseInplaceVersionUpgradeOptions *SetDatabaseInplaceVersionUpgradeOptions, waitForTaskOptions *WaitForTaskOptions) (result *SetDatabaseInplaceVersionUpgradeResponse, task *Task, response *core.DetailedResponse, err error)
+}
+
+var _ CloudDatabasesAPI = (*CloudDatabasesV5)(nil)
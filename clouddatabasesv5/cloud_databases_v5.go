@@ -30,6 +30,7 @@ import (
 	"time"
 
 	common "github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/cloud-databases-go-sdk/crn"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/go-openapi/strfmt"
 )
@@ -47,6 +48,9 @@ import (
 // API Version: 5.0.0
 type CloudDatabasesV5 struct {
 	Service *core.BaseService
+
+	allowlistGuard *AllowlistGuard
+	deploymentTypes *deploymentTypeCache
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -67,6 +71,15 @@ type CloudDatabasesV5Options struct {
 	ServiceName   string
 	URL           string
 	Authenticator core.Authenticator
+
+	// The region of the endpoint to use, for example "eu-de". Ignored when URL is set.
+	Region string
+
+	// The platform segment of the endpoint path. Defaults to "ibm". Ignored when URL is set.
+	Platform string
+
+	// Use the private endpoint of the region, reachable only from the IBM Cloud private network. Ignored when URL is set.
+	PrivateEndpoint bool
 }
 
 // NewCloudDatabasesV5UsingExternalConfig : constructs an instance of CloudDatabasesV5 with passed in options and external configuration.
@@ -83,6 +96,14 @@ func NewCloudDatabasesV5UsingExternalConfig(options *CloudDatabasesV5Options) (c
 		}
 	}
 
+	explicitEndpoint := hasEndpointOptions(options)
+	if !explicitEndpoint {
+		err = loadEndpointOptions(options)
+		if err != nil {
+			return
+		}
+	}
+
 	cloudDatabases, err = NewCloudDatabasesV5(options)
 	err = core.RepurposeSDKProblem(err, "new-client-error")
 	if err != nil {
@@ -98,6 +119,13 @@ func NewCloudDatabasesV5UsingExternalConfig(options *CloudDatabasesV5Options) (c
 	if options.URL != "" {
 		err = cloudDatabases.Service.SetServiceURL(options.URL)
 		err = core.RepurposeSDKProblem(err, "url-set-error")
+	} else if explicitEndpoint {
+		var serviceURL string
+		serviceURL, err = serviceURLForOptions(options)
+		if err == nil {
+			err = cloudDatabases.Service.SetServiceURL(serviceURL)
+		}
+		err = core.RepurposeSDKProblem(err, "url-set-error")
 	}
 	return
 }
@@ -121,10 +149,23 @@ func NewCloudDatabasesV5(options *CloudDatabasesV5Options) (service *CloudDataba
 			err = core.SDKErrorf(err, "", "set-url-error", common.GetComponentInfo())
 			return
 		}
+	} else if hasEndpointOptions(options) {
+		var serviceURL string
+		serviceURL, err = serviceURLForOptions(options)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "set-url-error")
+			return
+		}
+		err = baseService.SetServiceURL(serviceURL)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "set-url-error", common.GetComponentInfo())
+			return
+		}
 	}
 
 	service = &CloudDatabasesV5{
 		Service: baseService,
+		deploymentTypes: &deploymentTypeCache{},
 	}
 
 	return
@@ -132,7 +173,10 @@ func NewCloudDatabasesV5(options *CloudDatabasesV5Options) (service *CloudDataba
 
 // GetServiceURLForRegion returns the service URL to be used for the specified region
 func GetServiceURLForRegion(region string) (string, error) {
-	return "", core.SDKErrorf(nil, "service does not support regional URLs", "no-regional-support", common.GetComponentInfo())
+	if !IsSupportedRegion(region) {
+		return "", core.SDKErrorf(nil, fmt.Sprintf("service does not support region '%s'", region), "unsupported-region", common.GetComponentInfo())
+	}
+	return ConstructServiceURL(map[string]string{"region": region})
 }
 
 // Clone makes a copy of "cloudDatabases" suitable for processing requests.
@@ -235,7 +279,7 @@ func (cloudDatabases *CloudDatabasesV5) ListDeployablesWithContext(ctx context.C
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "listDeployables", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -296,7 +340,7 @@ func (cloudDatabases *CloudDatabasesV5) ListRegionsWithContext(ctx context.Conte
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "listRegions", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -331,6 +375,11 @@ func (cloudDatabases *CloudDatabasesV5) GetDeploymentInfoWithContext(ctx context
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*getDeploymentInfoOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *getDeploymentInfoOptions.ID,
@@ -365,7 +414,7 @@ func (cloudDatabases *CloudDatabasesV5) GetDeploymentInfoWithContext(ctx context
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getDeploymentInfo", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -400,6 +449,16 @@ func (cloudDatabases *CloudDatabasesV5) CreateDatabaseUserWithContext(ctx contex
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*createDatabaseUserOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
+	err = validateUserRole(*createDatabaseUserOptions.UserType, createDatabaseUserOptions.User)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "redis-role-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *createDatabaseUserOptions.ID,
@@ -446,7 +505,7 @@ func (cloudDatabases *CloudDatabasesV5) CreateDatabaseUserWithContext(ctx contex
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "createDatabaseUser", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -481,6 +540,16 @@ func (cloudDatabases *CloudDatabasesV5) UpdateUserWithContext(ctx context.Contex
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*updateUserOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
+	err = validateUserRole(*updateUserOptions.UserType, updateUserOptions.User)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "redis-role-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *updateUserOptions.ID,
@@ -528,7 +597,7 @@ func (cloudDatabases *CloudDatabasesV5) UpdateUserWithContext(ctx context.Contex
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "updateUser", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -563,6 +632,11 @@ func (cloudDatabases *CloudDatabasesV5) DeleteDatabaseUserWithContext(ctx contex
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*deleteDatabaseUserOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *deleteDatabaseUserOptions.ID,
@@ -599,7 +673,7 @@ func (cloudDatabases *CloudDatabasesV5) DeleteDatabaseUserWithContext(ctx contex
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "deleteDatabaseUser", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -634,6 +708,16 @@ func (cloudDatabases *CloudDatabasesV5) UpdateDatabaseConfigurationWithContext(c
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*updateDatabaseConfigurationOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
+	err = updateDatabaseConfigurationOptions.validateConfiguration()
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "configuration-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *updateDatabaseConfigurationOptions.ID,
@@ -679,7 +763,7 @@ func (cloudDatabases *CloudDatabasesV5) UpdateDatabaseConfigurationWithContext(c
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "updateDatabaseConfiguration", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -714,6 +798,11 @@ func (cloudDatabases *CloudDatabasesV5) ListRemotesWithContext(ctx context.Conte
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*listRemotesOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *listRemotesOptions.ID,
@@ -748,7 +837,7 @@ func (cloudDatabases *CloudDatabasesV5) ListRemotesWithContext(ctx context.Conte
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "listRemotes", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -783,6 +872,11 @@ func (cloudDatabases *CloudDatabasesV5) ResyncReplicaWithContext(ctx context.Con
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*resyncReplicaOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *resyncReplicaOptions.ID,
@@ -817,7 +911,7 @@ func (cloudDatabases *CloudDatabasesV5) ResyncReplicaWithContext(ctx context.Con
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "resyncReplica", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -853,6 +947,11 @@ func (cloudDatabases *CloudDatabasesV5) PromoteReadOnlyReplicaWithContext(ctx co
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*promoteReadOnlyReplicaOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *promoteReadOnlyReplicaOptions.ID,
@@ -898,7 +997,7 @@ func (cloudDatabases *CloudDatabasesV5) PromoteReadOnlyReplicaWithContext(ctx co
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "promoteReadOnlyReplica", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -934,6 +1033,11 @@ func (cloudDatabases *CloudDatabasesV5) ListDeploymentTasksWithContext(ctx conte
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*listDeploymentTasksOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *listDeploymentTasksOptions.ID,
@@ -968,7 +1072,7 @@ func (cloudDatabases *CloudDatabasesV5) ListDeploymentTasksWithContext(ctx conte
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "listDeploymentTasks", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1004,6 +1108,11 @@ func (cloudDatabases *CloudDatabasesV5) GetTaskWithContext(ctx context.Context,
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*getTaskOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *getTaskOptions.ID,
@@ -1038,7 +1147,7 @@ func (cloudDatabases *CloudDatabasesV5) GetTaskWithContext(ctx context.Context,
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getTask", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1073,6 +1182,11 @@ func (cloudDatabases *CloudDatabasesV5) GetBackupInfoWithContext(ctx context.Con
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*getBackupInfoOptions.BackupID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"backup_id": *getBackupInfoOptions.BackupID,
@@ -1107,7 +1221,7 @@ func (cloudDatabases *CloudDatabasesV5) GetBackupInfoWithContext(ctx context.Con
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getBackupInfo", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1142,6 +1256,11 @@ func (cloudDatabases *CloudDatabasesV5) ListDeploymentBackupsWithContext(ctx con
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*listDeploymentBackupsOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *listDeploymentBackupsOptions.ID,
@@ -1176,7 +1295,7 @@ func (cloudDatabases *CloudDatabasesV5) ListDeploymentBackupsWithContext(ctx con
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "listDeploymentBackups", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1212,6 +1331,11 @@ func (cloudDatabases *CloudDatabasesV5) StartOndemandBackupWithContext(ctx conte
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*startOndemandBackupOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *startOndemandBackupOptions.ID,
@@ -1246,7 +1370,7 @@ func (cloudDatabases *CloudDatabasesV5) StartOndemandBackupWithContext(ctx conte
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "startOndemandBackup", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1282,6 +1406,11 @@ func (cloudDatabases *CloudDatabasesV5) GetPitrDataWithContext(ctx context.Conte
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*getPitrDataOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *getPitrDataOptions.ID,
@@ -1316,7 +1445,7 @@ func (cloudDatabases *CloudDatabasesV5) GetPitrDataWithContext(ctx context.Conte
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getPITRData", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1351,6 +1480,11 @@ func (cloudDatabases *CloudDatabasesV5) GetConnectionWithContext(ctx context.Con
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*getConnectionOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *getConnectionOptions.ID,
@@ -1392,7 +1526,7 @@ func (cloudDatabases *CloudDatabasesV5) GetConnectionWithContext(ctx context.Con
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getConnection", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1428,6 +1562,11 @@ func (cloudDatabases *CloudDatabasesV5) CompleteConnectionWithContext(ctx contex
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*completeConnectionOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *completeConnectionOptions.ID,
@@ -1479,7 +1618,7 @@ func (cloudDatabases *CloudDatabasesV5) CompleteConnectionWithContext(ctx contex
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "completeConnection", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1515,6 +1654,11 @@ func (cloudDatabases *CloudDatabasesV5) ListDeploymentScalingGroupsWithContext(c
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*listDeploymentScalingGroupsOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *listDeploymentScalingGroupsOptions.ID,
@@ -1549,7 +1693,7 @@ func (cloudDatabases *CloudDatabasesV5) ListDeploymentScalingGroupsWithContext(c
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "listDeploymentScalingGroups", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1623,7 +1767,7 @@ func (cloudDatabases *CloudDatabasesV5) GetDefaultScalingGroupsWithContext(ctx c
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getDefaultScalingGroups", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1660,6 +1804,11 @@ func (cloudDatabases *CloudDatabasesV5) SetDeploymentScalingGroupWithContext(ctx
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*setDeploymentScalingGroupOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *setDeploymentScalingGroupOptions.ID,
@@ -1706,7 +1855,7 @@ func (cloudDatabases *CloudDatabasesV5) SetDeploymentScalingGroupWithContext(ctx
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "setDeploymentScalingGroup", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1742,6 +1891,11 @@ func (cloudDatabases *CloudDatabasesV5) GetAutoscalingConditionsWithContext(ctx
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*getAutoscalingConditionsOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *getAutoscalingConditionsOptions.ID,
@@ -1777,7 +1931,7 @@ func (cloudDatabases *CloudDatabasesV5) GetAutoscalingConditionsWithContext(ctx
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getAutoscalingConditions", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1813,6 +1967,11 @@ func (cloudDatabases *CloudDatabasesV5) SetAutoscalingConditionsWithContext(ctx
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*setAutoscalingConditionsOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *setAutoscalingConditionsOptions.ID,
@@ -1859,7 +2018,7 @@ func (cloudDatabases *CloudDatabasesV5) SetAutoscalingConditionsWithContext(ctx
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "setAutoscalingConditions", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1894,6 +2053,11 @@ func (cloudDatabases *CloudDatabasesV5) KillConnectionsWithContext(ctx context.C
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*killConnectionsOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *killConnectionsOptions.ID,
@@ -1928,7 +2092,7 @@ func (cloudDatabases *CloudDatabasesV5) KillConnectionsWithContext(ctx context.C
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "killConnections", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1963,6 +2127,11 @@ func (cloudDatabases *CloudDatabasesV5) CreateLogicalReplicationSlotWithContext(
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*createLogicalReplicationSlotOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *createLogicalReplicationSlotOptions.ID,
@@ -2008,7 +2177,7 @@ func (cloudDatabases *CloudDatabasesV5) CreateLogicalReplicationSlotWithContext(
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "createLogicalReplicationSlot", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2043,6 +2212,11 @@ func (cloudDatabases *CloudDatabasesV5) DeleteLogicalReplicationSlotWithContext(
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*deleteLogicalReplicationSlotOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *deleteLogicalReplicationSlotOptions.ID,
@@ -2078,7 +2252,7 @@ func (cloudDatabases *CloudDatabasesV5) DeleteLogicalReplicationSlotWithContext(
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "deleteLogicalReplicationSlot", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2113,6 +2287,11 @@ func (cloudDatabases *CloudDatabasesV5) GetAllowlistWithContext(ctx context.Cont
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*getAllowlistOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *getAllowlistOptions.ID,
@@ -2147,7 +2326,7 @@ func (cloudDatabases *CloudDatabasesV5) GetAllowlistWithContext(ctx context.Cont
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getAllowlist", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2184,6 +2363,21 @@ func (cloudDatabases *CloudDatabasesV5) SetAllowlistWithContext(ctx context.Cont
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*setAllowlistOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
+	ipAddresses, err := setAllowlistOptions.normalizedIPAddresses()
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "allowlist-validation-error")
+		return
+	}
+	err = cloudDatabases.guardSetAllowlist(ctx, setAllowlistOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "allowlist-guard-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *setAllowlistOptions.ID,
@@ -2213,8 +2407,8 @@ func (cloudDatabases *CloudDatabasesV5) SetAllowlistWithContext(ctx context.Cont
 	}
 
 	body := make(map[string]interface{})
-	if setAllowlistOptions.IPAddresses != nil {
-		body["ip_addresses"] = setAllowlistOptions.IPAddresses
+	if ipAddresses != nil {
+		body["ip_addresses"] = ipAddresses
 	}
 	_, err = builder.SetBodyContentJSON(body)
 	if err != nil {
@@ -2232,7 +2426,7 @@ func (cloudDatabases *CloudDatabasesV5) SetAllowlistWithContext(ctx context.Cont
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "setAllowlist", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2267,6 +2461,16 @@ func (cloudDatabases *CloudDatabasesV5) AddAllowlistEntryWithContext(ctx context
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*addAllowlistEntryOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
+	ipAddress, err := addAllowlistEntryOptions.normalizedIPAddress()
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "allowlist-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *addAllowlistEntryOptions.ID,
@@ -2293,8 +2497,8 @@ func (cloudDatabases *CloudDatabasesV5) AddAllowlistEntryWithContext(ctx context
 	builder.AddHeader("Content-Type", "application/json")
 
 	body := make(map[string]interface{})
-	if addAllowlistEntryOptions.IPAddress != nil {
-		body["ip_address"] = addAllowlistEntryOptions.IPAddress
+	if ipAddress != nil {
+		body["ip_address"] = ipAddress
 	}
 	_, err = builder.SetBodyContentJSON(body)
 	if err != nil {
@@ -2312,7 +2516,7 @@ func (cloudDatabases *CloudDatabasesV5) AddAllowlistEntryWithContext(ctx context
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "addAllowlistEntry", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2347,6 +2551,16 @@ func (cloudDatabases *CloudDatabasesV5) DeleteAllowlistEntryWithContext(ctx cont
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*deleteAllowlistEntryOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
+	err = cloudDatabases.guardDeleteAllowlistEntry(ctx, deleteAllowlistEntryOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "allowlist-guard-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *deleteAllowlistEntryOptions.ID,
@@ -2382,7 +2596,7 @@ func (cloudDatabases *CloudDatabasesV5) DeleteAllowlistEntryWithContext(ctx cont
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "deleteAllowlistEntry", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2469,7 +2683,7 @@ func (cloudDatabases *CloudDatabasesV5) CreateCapabilityWithContext(ctx context.
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "createCapability", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2505,6 +2719,11 @@ func (cloudDatabases *CloudDatabasesV5) GetDeploymentCapabilityWithContext(ctx c
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*getDeploymentCapabilityOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *getDeploymentCapabilityOptions.ID,
@@ -2550,7 +2769,7 @@ func (cloudDatabases *CloudDatabasesV5) GetDeploymentCapabilityWithContext(ctx c
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getDeploymentCapability", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2585,6 +2804,11 @@ func (cloudDatabases *CloudDatabasesV5) SetDatabaseInplaceVersionUpgradeWithCont
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = crn.ValidateID(*setDatabaseInplaceVersionUpgradeOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *setDatabaseInplaceVersionUpgradeOptions.ID,
@@ -2637,7 +2861,7 @@ func (cloudDatabases *CloudDatabasesV5) SetDatabaseInplaceVersionUpgradeWithCont
 	response, err = cloudDatabases.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "setDatabaseInplaceVersionUpgrade", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4544,6 +4768,9 @@ type DeleteAllowlistEntryOptions struct {
 	// An IPv4 address or a CIDR range (netmasked IPv4 address).
 	Ipaddress *string `json:"ipaddress" validate:"required,ne="`
 
+	// Delete the entry even if the allowlist guard of the client refuses it. Not sent to the service.
+	Force *bool `json:"-"`
+
 	// Allows users to set headers on API requests.
 	Headers map[string]string
 }
@@ -7069,6 +7296,9 @@ type SetAllowlistOptions struct {
 	// header to ensure synchronicity between clients.
 	IfMatch *string `json:"If-Match,omitempty"`
 
+	// Apply the allowlist even if the allowlist guard of the client refuses it. Not sent to the service.
+	Force *bool `json:"-"`
+
 	// Allows users to set headers on API requests.
 	Headers map[string]string
 }
@@ -7480,6 +7710,9 @@ type UpdateDatabaseConfigurationOptions struct {
 
 	Configuration ConfigurationIntf `json:"configuration,omitempty"`
 
+	// Send the configuration without checking it with its Validate method first. Not sent to the service.
+	SkipValidation *bool `json:"-"`
+
 	// Allows users to set headers on API requests.
 	Headers map[string]string
 }
@@ -8646,7 +8879,12 @@ type UserUpdatePasswordSetting struct {
 }
 
 // NewUserUpdatePasswordSetting : Instantiate UserUpdatePasswordSetting (Generic Model Constructor)
+// Pass AutoGeneratePassword as the password to have one generated; it is returned in the Password field.
 func (*CloudDatabasesV5) NewUserUpdatePasswordSetting(password string) (_model *UserUpdatePasswordSetting, err error) {
+	password, err = generatePassword(password, OpsManagerPasswordPolicy)
+	if err != nil {
+		return
+	}
 	_model = &UserUpdatePasswordSetting{
 		Password: core.StringPtr(password),
 	}
@@ -8677,7 +8915,8 @@ func UnmarshalUserUpdatePasswordSetting(m map[string]json.RawMessage, result int
 // This model "extends" UserUpdate
 type UserUpdateRedisRoleSetting struct {
 	// RBAC role for redis database user types. Available for Redis 6.0 and above. Must use Redis ACL syntax to add or
-	// remove command categories. Allowed categories are `read`, `write`, `admin` and `all`.
+	// remove command categories. Allowed categories are `read`, `write`, `admin` and `all`. Build it with RedisRole; it
+	// is checked with ValidateRedisRole by UpdateUser before the update is sent.
 	Role *string `json:"role" validate:"required"`
 }
 
@@ -8720,7 +8959,12 @@ type UserDatabaseUser struct {
 }
 
 // NewUserDatabaseUser : Instantiate UserDatabaseUser (Generic Model Constructor)
+// Pass AutoGeneratePassword as the password to have one generated; it is returned in the Password field.
 func (*CloudDatabasesV5) NewUserDatabaseUser(username string, password string) (_model *UserDatabaseUser, err error) {
+	password, err = generatePassword(password, DatabasePasswordPolicy)
+	if err != nil {
+		return
+	}
 	_model = &UserDatabaseUser{
 		Username: core.StringPtr(username),
 		Password: core.StringPtr(password),
@@ -8775,7 +9019,12 @@ const (
 )
 
 // NewUserOpsManagerUser : Instantiate UserOpsManagerUser (Generic Model Constructor)
+// Pass AutoGeneratePassword as the password to have one generated; it is returned in the Password field.
 func (*CloudDatabasesV5) NewUserOpsManagerUser(username string, password string) (_model *UserOpsManagerUser, err error) {
+	password, err = generatePassword(password, OpsManagerPasswordPolicy)
+	if err != nil {
+		return
+	}
 	_model = &UserOpsManagerUser{
 		Username: core.StringPtr(username),
 		Password: core.StringPtr(password),
@@ -8823,12 +9072,18 @@ type UserRedisDatabaseUser struct {
 	Password *string `json:"password" validate:"required"`
 
 	// RBAC role for Redis database user types. Available for Redis 6.0 and newer. Must use Redis ACL syntax to add or
-	// remove command categories. Allowed categories are `read`, `write`, `admin` and `all`.
+	// remove command categories. Allowed categories are `read`, `write`, `admin` and `all`. Build it with RedisRole; it
+	// is checked with ValidateRedisRole by CreateDatabaseUser before the user is created.
 	Role *string `json:"role,omitempty"`
 }
 
 // NewUserRedisDatabaseUser : Instantiate UserRedisDatabaseUser (Generic Model Constructor)
+// Pass AutoGeneratePassword as the password to have one generated; it is returned in the Password field.
 func (*CloudDatabasesV5) NewUserRedisDatabaseUser(username string, password string) (_model *UserRedisDatabaseUser, err error) {
+	password, err = generatePassword(password, DatabasePasswordPolicy)
+	if err != nil {
+		return
+	}
 	_model = &UserRedisDatabaseUser{
 		Username: core.StringPtr(username),
 		Password: core.StringPtr(password),
@@ -20,7 +20,9 @@
 package clouddatabasesv5_test
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -73,33 +75,16 @@ var (
 )
 
 var waitForTask = func(taskID string) {
-	getTaskOptions := &clouddatabasesv5.GetTaskOptions{
-		ID: &taskID,
-	}
+	waitForTaskOptions := cloudDatabasesService.NewWaitForTaskOptions().
+		SetTimeout(time.Minute)
+
+	_, err := cloudDatabasesService.WaitForTask(context.Background(), taskID, waitForTaskOptions)
 
 	// If the task runs for more than a minute, then we'll consider it to have succeeded.
-	for complete, attempts := false, 0; !complete && attempts < 30; attempts++ {
-		getTaskResponse, response, err := cloudDatabasesService.GetTask(getTaskOptions)
-
-		Expect(err).To(BeNil())
-		Expect(response.StatusCode).To(Equal(200))
-		Expect(getTaskResponse).ToNot(BeNil())
-
-		if getTaskResponse.Task == nil {
-			complete = true
-		} else {
-			switch *getTaskResponse.Task.Status {
-			case "completed", "failed":
-				complete = true
-				Expect(*getTaskResponse.Task.Status).To(Equal("completed"))
-			case "queued", "running":
-				break // from switch, not from for
-			default:
-				fmt.Println("status is " + *getTaskResponse.Task.Status)
-			}
-		}
-		time.Sleep(2 * time.Second)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return
 	}
+	Expect(err).To(BeNil())
 }
 
 func shouldSkipTest() {
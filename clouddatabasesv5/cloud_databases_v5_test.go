@@ -154,6 +154,65 @@ var _ = Describe(`CloudDatabasesV5`, func() {
 				ClearTestEnvironment(testEnvironment)
 			})
 		})
+		Context(`Using external config, construct service client instances with endpoint options`, func() {
+			// Map containing environment variables used in testing.
+			var testEnvironment = map[string]string{
+				"CLOUD_DATABASES_AUTH_TYPE": "noauth",
+				"CLOUD_DATABASES_REGION": "ca-tor",
+				"CLOUD_DATABASES_PLATFORM": "ibm",
+				"CLOUD_DATABASES_PRIVATE_ENDPOINT": "true",
+			}
+
+			It(`Create service client using external config with region, platform and private endpoint successfully`, func() {
+				SetTestEnvironment(testEnvironment)
+				cloudDatabasesService, serviceErr := clouddatabasesv5.NewCloudDatabasesV5UsingExternalConfig(&clouddatabasesv5.CloudDatabasesV5Options{
+				})
+				Expect(cloudDatabasesService).ToNot(BeNil())
+				Expect(serviceErr).To(BeNil())
+				Expect(cloudDatabasesService.GetServiceURL()).To(Equal("https://api.ca-tor.private.databases.cloud.ibm.com/v5/ibm"))
+				ClearTestEnvironment(testEnvironment)
+			})
+			It(`Create service client using external config and set region from constructor successfully`, func() {
+				SetTestEnvironment(testEnvironment)
+				cloudDatabasesService, serviceErr := clouddatabasesv5.NewCloudDatabasesV5UsingExternalConfig(&clouddatabasesv5.CloudDatabasesV5Options{
+					Region: "eu-gb",
+				})
+				Expect(cloudDatabasesService).ToNot(BeNil())
+				Expect(serviceErr).To(BeNil())
+				Expect(cloudDatabasesService.GetServiceURL()).To(Equal("https://api.eu-gb.databases.cloud.ibm.com/v5/ibm"))
+				ClearTestEnvironment(testEnvironment)
+			})
+			It(`Create service client using external config with url and region successfully`, func() {
+				var testEnvironmentWithURL = map[string]string{
+					"CLOUD_DATABASES_URL": "https://clouddatabasesv5/api",
+					"CLOUD_DATABASES_AUTH_TYPE": "noauth",
+					"CLOUD_DATABASES_REGION": "ca-tor",
+				}
+				SetTestEnvironment(testEnvironmentWithURL)
+				cloudDatabasesService, serviceErr := clouddatabasesv5.NewCloudDatabasesV5UsingExternalConfig(&clouddatabasesv5.CloudDatabasesV5Options{
+				})
+				Expect(cloudDatabasesService).ToNot(BeNil())
+				Expect(serviceErr).To(BeNil())
+				Expect(cloudDatabasesService.GetServiceURL()).To(Equal("https://clouddatabasesv5/api"))
+				ClearTestEnvironment(testEnvironmentWithURL)
+			})
+		})
+		Context(`Using external config, construct service client instances with error: Invalid private endpoint`, func() {
+			// Map containing environment variables used in testing.
+			var testEnvironment = map[string]string{
+				"CLOUD_DATABASES_AUTH_TYPE": "noauth",
+				"CLOUD_DATABASES_PRIVATE_ENDPOINT": "sometimes",
+			}
+
+			It(`Instantiate service client with error`, func() {
+				SetTestEnvironment(testEnvironment)
+				cloudDatabasesService, serviceErr := clouddatabasesv5.NewCloudDatabasesV5UsingExternalConfig(&clouddatabasesv5.CloudDatabasesV5Options{
+				})
+				Expect(cloudDatabasesService).To(BeNil())
+				Expect(serviceErr).ToNot(BeNil())
+				ClearTestEnvironment(testEnvironment)
+			})
+		})
 	})
 	Describe(`Regional endpoint tests`, func() {
 		It(`GetServiceURLForRegion(region string)`, func() {
@@ -0,0 +1,88 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package configuration reports the drift between the configuration of a Cloud Databases deployment and a desired
+// configuration, declared in code or kept in a YAML or JSON file, and applies the fields that differ.
+//
+// LoadFile reads the desired configuration into the configuration model of the database type, NewPlan compares it
+// with the current configuration of a deployment, and Plan.Apply sends the changed fields, or only prints them in
+// dry-run mode:
+//
+//	desired := &clouddatabasesv5.ConfigurationPgConfiguration{}
+//	err := configuration.LoadFile("postgresql.yaml", desired)
+//	...
+//	plan, err := configuration.NewPlan(ctx, cloudDatabasesService, deploymentID, desired)
+//	...
+//	task, err := plan.Apply(ctx, cloudDatabasesService, &configuration.ApplyOptions{DryRun: true, Output: os.Stdout})
+package configuration
+
+import (
+	"io"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5/internal/declarative"
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Format : The format of a configuration file.
+type Format = declarative.Format
+
+// Constants associated with the Format type.
+const (
+	// A YAML mapping of the configuration fields, named as in the API (for example "max_connections"), or a mapping
+	// with the fields under "configuration".
+	FormatYAML = declarative.FormatYAML
+
+	// A JSON object of the configuration fields, named as in the API, or an object with the fields under
+	// "configuration", like the body of UpdateDatabaseConfiguration.
+	FormatJSON = declarative.FormatJSON
+)
+
+// FormatForPath returns the format of a file from its extension: ".yaml" or ".yml", or ".json".
+func FormatForPath(path string) (Format, error) {
+	return declarative.FormatForPath(path, "configuration", FormatYAML, FormatJSON)
+}
+
+// LoadFile reads a configuration from a file, in the format given by its extension. See Load.
+func LoadFile(path string, configuration clouddatabasesv5.ConfigurationIntf) error {
+	return declarative.LoadFile(path, "configuration", []Format{FormatYAML, FormatJSON}, func(reader io.Reader, format Format) error {
+		return Load(reader, format, configuration)
+	})
+}
+
+// Load reads a configuration in the specified format into the configuration model, for example a
+// *clouddatabasesv5.ConfigurationPgConfiguration. Fields the model does not have are an error, and the configuration
+// is checked with its Validate method.
+func Load(reader io.Reader, format Format, configuration clouddatabasesv5.ConfigurationIntf) error {
+	err := core.ValidateNotNil(configuration, "configuration cannot be nil")
+	if err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+
+	err = declarative.Decode(reader, format, "configuration", "configuration", configuration)
+	if err != nil {
+		return err
+	}
+
+	if validated, ok := configuration.(interface{ Validate() error }); ok {
+		err = validated.Validate()
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "invalid-configuration")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedConfiguration = &clouddatabasesv5.ConfigurationPgConfiguration{
+	MaxConnections: core.Int64Ptr(200),
+	WalLevel:       core.StringPtr("logical"),
+}
+
+func TestLoadFormats(t *testing.T) {
+	documents := map[Format][]string{
+		FormatYAML: {
+			"max_connections: 200\nwal_level: logical\n",
+			"configuration:\n  max_connections: 200\n  wal_level: logical\n",
+		},
+		FormatJSON: {
+			`{"max_connections": 200, "wal_level": "logical"}`,
+			`{"configuration": {"max_connections": 200, "wal_level": "logical"}}`,
+		},
+	}
+	for format, formatDocuments := range documents {
+		for _, document := range formatDocuments {
+			configuration := &clouddatabasesv5.ConfigurationPgConfiguration{}
+			err := Load(strings.NewReader(document), format, configuration)
+			require.Nil(t, err, document)
+			assert.Equal(t, expectedConfiguration, configuration, document)
+		}
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	// A field of another database type.
+	err := Load(strings.NewReader("maxmemory-policy: allkeys-lru\n"), FormatYAML, &clouddatabasesv5.ConfigurationPgConfiguration{})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "maxmemory-policy")
+
+	err = Load(strings.NewReader(`{"max_connections": 10}`), FormatJSON, &clouddatabasesv5.ConfigurationPgConfiguration{})
+	assert.True(t, errors.Is(err, clouddatabasesv5.ErrValidation))
+
+	err = Load(strings.NewReader(`{"max_connections": "many"}`), FormatJSON, &clouddatabasesv5.ConfigurationPgConfiguration{})
+	assert.NotNil(t, err)
+
+	err = Load(strings.NewReader(""), FormatYAML, nil)
+	assert.NotNil(t, err)
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "mysql.yml")
+	require.Nil(t, os.WriteFile(path, []byte("innodb_buffer_pool_size_percentage: 50\nsql_mode: ANSI\n"), 0o600))
+
+	configuration := &clouddatabasesv5.ConfigurationMySQLConfiguration{}
+	require.Nil(t, LoadFile(path, configuration))
+	assert.Equal(t, int64(50), *configuration.InnodbBufferPoolSizePercentage)
+	assert.Equal(t, "ANSI", *configuration.SQLMode)
+
+	_, err := FormatForPath(filepath.Join(dir, "mysql.toml"))
+	assert.NotNil(t, err)
+}
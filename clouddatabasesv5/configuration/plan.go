@@ -0,0 +1,282 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5/internal/declarative"
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Plan : The changes that make the configuration of a deployment match a desired configuration.
+// Only the fields set in the desired configuration are compared; the other fields are left as they are.
+type Plan struct {
+	// The ID of the deployment.
+	DeploymentID string
+
+	// The current values of the fields set in the desired configuration when the plan was made, as reported by
+	// GetDatabaseConfigurationSchema. Fields without a current value, or whose value does not fit the model, are unset.
+	Current clouddatabasesv5.ConfigurationIntf
+
+	// The desired configuration.
+	Desired clouddatabasesv5.ConfigurationIntf
+
+	// The fields whose current value differs from the desired value, sorted by field name.
+	Changes []Change
+}
+
+// Change : A configuration field whose current value differs from the desired value.
+type Change struct {
+	// The name of the field as sent to the service, for example "max_connections".
+	Field string
+
+	// The current value, as decoded from JSON, or nil if the deployment does not report the field.
+	Current interface{}
+
+	// The desired value, as decoded from JSON.
+	Desired interface{}
+
+	// Whether changing the field restarts the database.
+	RequiresRestart bool
+}
+
+// ApplyOptions : The Plan.Apply options.
+type ApplyOptions struct {
+	// Only write the diff of the plan to Output, without making any change.
+	DryRun bool
+
+	// Where the diff is written in dry-run mode. Required when DryRun is set.
+	Output io.Writer
+
+	// The options used to wait for the task. See clouddatabasesv5.CloudDatabasesV5.WaitForTask.
+	WaitForTaskOptions *clouddatabasesv5.WaitForTaskOptions
+}
+
+// NewPlan gets the current configuration values of the deployment with GetDatabaseConfigurationSchema and compares
+// them with the desired configuration, such as a *clouddatabasesv5.ConfigurationPgConfiguration declared in code or
+// read by LoadFile. Only the fields set in the desired configuration are compared; a field whose current value is
+// missing from the schema, or does not fit the model, is reported as changed from unset.
+func NewPlan(ctx context.Context, api clouddatabasesv5.CloudDatabasesAPI, id string, desired clouddatabasesv5.ConfigurationIntf) (plan *Plan, err error) {
+	err = core.ValidateNotNil(desired, "desired cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	getDatabaseConfigurationSchemaOptions := (*clouddatabasesv5.CloudDatabasesV5)(nil).NewGetDatabaseConfigurationSchemaOptions(id)
+	schema, _, err := api.GetDatabaseConfigurationSchemaWithContext(ctx, getDatabaseConfigurationSchemaOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-configuration-schema-error")
+		return
+	}
+	var settings map[string]clouddatabasesv5.ConfigurationSchemaSetting
+	if schema != nil {
+		settings = schema.Schema
+	}
+
+	desiredFields, err := fieldValues(desired)
+	if err != nil {
+		return nil, err
+	}
+	current, err := currentConfiguration(desired, desiredFields, settings)
+	if err != nil {
+		return nil, err
+	}
+	currentFields, err := fieldValues(current)
+	if err != nil {
+		return nil, err
+	}
+
+	plan = &Plan{
+		DeploymentID: id,
+		Current:      current,
+		Desired:      desired,
+	}
+	for field, desiredValue := range desiredFields {
+		currentValue, ok := currentFields[field]
+		if !ok || !reflect.DeepEqual(currentValue, desiredValue) {
+			setting := settings[field]
+			plan.Changes = append(plan.Changes, Change{
+				Field:           field,
+				Current:         currentValue,
+				Desired:         desiredValue,
+				RequiresRestart: setting.RequiresRestart != nil && *setting.RequiresRestart,
+			})
+		}
+	}
+	sort.Slice(plan.Changes, func(i, j int) bool {
+		return plan.Changes[i].Field < plan.Changes[j].Field
+	})
+	return
+}
+
+// IsEmpty returns true if the configuration already matches the desired configuration.
+func (plan *Plan) IsEmpty() bool {
+	return len(plan.Changes) == 0
+}
+
+// String returns a readable diff of the plan: one line per changed field, with its current and desired values.
+func (plan *Plan) String() string {
+	builder := &strings.Builder{}
+	fmt.Fprintf(builder, "Configuration of %s:\n", plan.DeploymentID)
+	if plan.IsEmpty() {
+		builder.WriteString("  no changes\n")
+		return builder.String()
+	}
+
+	writer := tabwriter.NewWriter(builder, 0, 0, 2, ' ', 0)
+	for _, change := range plan.Changes {
+		var restart string
+		if change.RequiresRestart {
+			restart = "\t(restarts the database)"
+		}
+		fmt.Fprintf(writer, "~ %s\t%s -> %s%s\n", change.Field, formatValue(change.Current), formatValue(change.Desired), restart)
+	}
+	writer.Flush()
+	fmt.Fprintf(builder, "%d to change\n", len(plan.Changes))
+	return builder.String()
+}
+
+// Apply sends the changed fields of the plan with UpdateDatabaseConfiguration and waits for the resulting task. The
+// task is also returned along with the error if the task fails. Nothing is changed when the plan is empty or in
+// dry-run mode.
+func (plan *Plan) Apply(ctx context.Context, api clouddatabasesv5.CloudDatabasesAPI, options *ApplyOptions) (task *clouddatabasesv5.Task, err error) {
+	if options == nil {
+		options = &ApplyOptions{}
+	}
+
+	if options.DryRun {
+		err = declarative.WriteDryRun(options.Output, plan)
+		return
+	}
+	if plan.IsEmpty() {
+		return
+	}
+
+	changed, err := plan.changedConfiguration()
+	if err != nil {
+		return
+	}
+	updateDatabaseConfigurationOptions := (*clouddatabasesv5.CloudDatabasesV5)(nil).NewUpdateDatabaseConfigurationOptions(plan.DeploymentID)
+	updateDatabaseConfigurationOptions.SetConfiguration(changed)
+	_, task, _, err = api.UpdateDatabaseConfigurationAndWait(ctx, updateDatabaseConfigurationOptions, options.WaitForTaskOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "update-configuration-error")
+	}
+	return
+}
+
+// Sync makes a plan for the deployment and applies it. The plan is returned along with the task.
+func Sync(ctx context.Context, api clouddatabasesv5.CloudDatabasesAPI, id string, desired clouddatabasesv5.ConfigurationIntf, options *ApplyOptions) (plan *Plan, task *clouddatabasesv5.Task, err error) {
+	return declarative.Sync(func() (*Plan, error) {
+		return NewPlan(ctx, api, id, desired)
+	}, func(plan *Plan) (*clouddatabasesv5.Task, error) {
+		return plan.Apply(ctx, api, options)
+	})
+}
+
+// changedConfiguration returns a configuration of the model of the desired configuration holding only the changed
+// fields.
+func (plan *Plan) changedConfiguration() (clouddatabasesv5.ConfigurationIntf, error) {
+	fields := make(map[string]interface{}, len(plan.Changes))
+	for _, change := range plan.Changes {
+		fields[change.Field] = change.Desired
+	}
+	buf, err := json.Marshal(fields)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "marshal-error", common.GetComponentInfo())
+	}
+	changed := reflect.New(reflect.TypeOf(plan.Desired).Elem()).Interface().(clouddatabasesv5.ConfigurationIntf)
+	err = json.Unmarshal(buf, changed)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "unmarshal-error", common.GetComponentInfo())
+	}
+	return changed, nil
+}
+
+// currentConfiguration returns a configuration of the model of the desired configuration holding the current values
+// of the desired fields. Settings without a value, and values that do not fit the field of the model, are left out
+// and so show as unset.
+func currentConfiguration(desired clouddatabasesv5.ConfigurationIntf, desiredFields map[string]interface{}, settings map[string]clouddatabasesv5.ConfigurationSchemaSetting) (clouddatabasesv5.ConfigurationIntf, error) {
+	modelType := reflect.TypeOf(desired).Elem()
+	current := reflect.New(modelType).Interface().(clouddatabasesv5.ConfigurationIntf)
+	for field := range desiredFields {
+		value := settings[field].Value
+		if value == nil {
+			continue
+		}
+		buf, err := json.Marshal(map[string]interface{}{field: value})
+		if err != nil {
+			return nil, core.SDKErrorf(err, "", "marshal-error", common.GetComponentInfo())
+		}
+		// A value that does not fit leaves a zero value behind, so it is decoded on its own first.
+		var typeErr *json.UnmarshalTypeError
+		err = json.Unmarshal(buf, reflect.New(modelType).Interface())
+		if errors.As(err, &typeErr) {
+			continue
+		}
+		if err == nil {
+			err = json.Unmarshal(buf, current)
+		}
+		if err != nil {
+			return nil, core.SDKErrorf(err, "", "unmarshal-error", common.GetComponentInfo())
+		}
+	}
+	return current, nil
+}
+
+// fieldValues returns the fields set in the configuration, as sent to the service, with their values decoded from
+// JSON. Numbers are decoded as json.Number so that values of different models compare equal.
+func fieldValues(configuration clouddatabasesv5.ConfigurationIntf) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if core.IsNil(configuration) {
+		return values, nil
+	}
+	buf, err := json.Marshal(configuration)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "marshal-error", common.GetComponentInfo())
+	}
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.UseNumber()
+	err = decoder.Decode(&values)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "unmarshal-error", common.GetComponentInfo())
+	}
+	return values, nil
+}
+
+func formatValue(value interface{}) string {
+	if value == nil {
+		return "(unset)"
+	}
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(buf)
+}
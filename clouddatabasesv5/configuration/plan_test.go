@@ -0,0 +1,147 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configuration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5/fake"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5/stub"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const deploymentID = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/abc123:d1a2b3c4-0000-4000-8000-000000000001::"
+
+func newTestService(t *testing.T) (*fake.Server, *clouddatabasesv5.CloudDatabasesV5) {
+	server, service := fake.NewTestService(t, nil, clouddatabasesv5.Deployment{ID: core.StringPtr(deploymentID), Type: core.StringPtr("postgresql")})
+
+	updateDatabaseConfigurationOptions := service.NewUpdateDatabaseConfigurationOptions(deploymentID)
+	updateDatabaseConfigurationOptions.SetConfiguration(&clouddatabasesv5.ConfigurationPgConfiguration{
+		MaxConnections: core.Int64Ptr(115),
+		SharedBuffers:  core.Int64Ptr(32),
+	})
+	_, _, _, err := service.UpdateDatabaseConfigurationAndWait(context.Background(), updateDatabaseConfigurationOptions, newApplyOptions().WaitForTaskOptions)
+	require.Nil(t, err)
+	return server, service
+}
+
+func newApplyOptions() *ApplyOptions {
+	return &ApplyOptions{
+		WaitForTaskOptions: (*clouddatabasesv5.CloudDatabasesV5)(nil).NewWaitForTaskOptions().SetPollInterval(time.Millisecond),
+	}
+}
+
+func TestPlan(t *testing.T) {
+	_, service := newTestService(t)
+
+	desired := &clouddatabasesv5.ConfigurationPgConfiguration{
+		MaxConnections: core.Int64Ptr(200),
+		SharedBuffers:  core.Int64Ptr(32),
+		WalLevel:       core.StringPtr("logical"),
+	}
+	plan, err := NewPlan(context.Background(), service, deploymentID, desired)
+	require.Nil(t, err)
+	require.Len(t, plan.Changes, 2)
+	assert.Equal(t, "max_connections", plan.Changes[0].Field)
+	assert.Equal(t, "wal_level", plan.Changes[1].Field)
+	assert.Nil(t, plan.Changes[1].Current)
+	assert.False(t, plan.IsEmpty())
+
+	output := &bytes.Buffer{}
+	task, err := plan.Apply(context.Background(), service, &ApplyOptions{DryRun: true, Output: output})
+	require.Nil(t, err)
+	assert.Nil(t, task)
+	assert.Equal(t, "Configuration of "+deploymentID+":\n"+
+		"~ max_connections  115 -> 200\n"+
+		`~ wal_level        (unset) -> "logical"`+"\n"+
+		"2 to change\n", output.String())
+
+	_, err = plan.Apply(context.Background(), service, &ApplyOptions{DryRun: true})
+	assert.NotNil(t, err)
+
+	plan, err = NewPlan(context.Background(), service, deploymentID, &clouddatabasesv5.ConfigurationPgConfiguration{SharedBuffers: core.Int64Ptr(32)})
+	require.Nil(t, err)
+	assert.True(t, plan.IsEmpty())
+	assert.Contains(t, plan.String(), "no changes")
+}
+
+func TestSync(t *testing.T) {
+	server, service := newTestService(t)
+
+	desired := &clouddatabasesv5.ConfigurationPgConfiguration{MaxConnections: core.Int64Ptr(200), WalLevel: core.StringPtr("logical")}
+	_, task, err := Sync(context.Background(), service, deploymentID, desired, newApplyOptions())
+	require.Nil(t, err)
+	require.NotNil(t, task)
+	assert.Equal(t, clouddatabasesv5.TaskStatusCompletedConst, *task.Status)
+
+	configuration := server.Configuration(deploymentID)
+	assert.EqualValues(t, 200, configuration["max_connections"])
+	assert.EqualValues(t, 32, configuration["shared_buffers"])
+	assert.Equal(t, "logical", configuration["wal_level"])
+
+	_, task, err = Sync(context.Background(), service, deploymentID, desired, newApplyOptions())
+	require.Nil(t, err)
+	assert.Nil(t, task)
+}
+
+func TestApplySendsChangedFields(t *testing.T) {
+	client := stub.NewClient()
+	client.GetDatabaseConfigurationSchemaFunc = stub.Return[clouddatabasesv5.GetDatabaseConfigurationSchemaOptions](&clouddatabasesv5.GetDatabaseConfigurationSchemaResponse{
+		Schema: map[string]clouddatabasesv5.ConfigurationSchemaSetting{
+			"max_connections": {Kind: core.StringPtr("integer"), RequiresRestart: core.BoolPtr(true), Value: float64(200)},
+			"wal_level":       {Kind: core.StringPtr("choice"), RequiresRestart: core.BoolPtr(true), Value: "replica"},
+			"maxmemory":       {Kind: core.StringPtr("integer"), Value: float64(100)},
+		},
+	})
+
+	desired := &clouddatabasesv5.ConfigurationPgConfiguration{MaxConnections: core.Int64Ptr(200), WalLevel: core.StringPtr("logical")}
+	plan, task, err := Sync(context.Background(), client, deploymentID, desired, nil)
+	require.Nil(t, err)
+	assert.Equal(t, clouddatabasesv5.TaskStatusCompletedConst, *task.Status)
+	assert.Equal(t, &clouddatabasesv5.ConfigurationPgConfiguration{MaxConnections: core.Int64Ptr(200), WalLevel: core.StringPtr("replica")}, plan.Current)
+	assert.Equal(t, []Change{{Field: "wal_level", Current: "replica", Desired: "logical", RequiresRestart: true}}, plan.Changes)
+	assert.Contains(t, plan.String(), `~ wal_level  "replica" -> "logical"  (restarts the database)`)
+
+	calls := client.CallsTo("UpdateDatabaseConfiguration")
+	require.Len(t, calls, 1)
+	sent := calls[0].Options.(*clouddatabasesv5.UpdateDatabaseConfigurationOptions)
+	assert.Equal(t, &clouddatabasesv5.ConfigurationPgConfiguration{WalLevel: core.StringPtr("logical")}, sent.Configuration)
+}
+
+func TestPlanSkipsValuesThatDoNotFitTheModel(t *testing.T) {
+	client := stub.NewClient()
+	client.GetDatabaseConfigurationSchemaFunc = stub.Return[clouddatabasesv5.GetDatabaseConfigurationSchemaOptions](&clouddatabasesv5.GetDatabaseConfigurationSchemaResponse{
+		Schema: map[string]clouddatabasesv5.ConfigurationSchemaSetting{
+			"max_connections":          {Kind: core.StringPtr("integer"), Value: float64(200)},
+			"shared_buffers":           {Kind: core.StringPtr("integer"), Value: "32MB"},
+			"effective_io_concurrency": {Kind: core.StringPtr("integer"), Value: "unlimited"},
+		},
+	})
+
+	desired := &clouddatabasesv5.ConfigurationPgConfiguration{MaxConnections: core.Int64Ptr(200), EffectiveIoConcurrency: core.Int64Ptr(12)}
+	plan, err := NewPlan(context.Background(), client, deploymentID, desired)
+	require.Nil(t, err)
+	assert.Equal(t, &clouddatabasesv5.ConfigurationPgConfiguration{MaxConnections: core.Int64Ptr(200)}, plan.Current)
+	assert.Equal(t, []Change{{Field: "effective_io_concurrency", Desired: json.Number("12")}}, plan.Changes)
+}
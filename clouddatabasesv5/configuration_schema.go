@@ -0,0 +1,189 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/cloud-databases-go-sdk/crn"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// GetDatabaseConfigurationSchema : Get the schema of your database configuration
+// Get the configuration settings of your database, with their limits, whether changing them restarts the database
+// and, when the service reports it, their current value. Available for PostgreSQL, EnterpriseDB, MySQL and Redis ONLY.
+//
+// This is the GET /deployments/{id}/configuration/schema operation of the service, which the API definition the
+// CloudDatabasesV5 operations are generated from does not include.
+func (cloudDatabases *CloudDatabasesV5) GetDatabaseConfigurationSchema(getDatabaseConfigurationSchemaOptions *GetDatabaseConfigurationSchemaOptions) (result *GetDatabaseConfigurationSchemaResponse, response *core.DetailedResponse, err error) {
+	result, response, err = cloudDatabases.GetDatabaseConfigurationSchemaWithContext(context.Background(), getDatabaseConfigurationSchemaOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetDatabaseConfigurationSchemaWithContext is an alternate form of the GetDatabaseConfigurationSchema method which supports a Context parameter
+func (cloudDatabases *CloudDatabasesV5) GetDatabaseConfigurationSchemaWithContext(ctx context.Context, getDatabaseConfigurationSchemaOptions *GetDatabaseConfigurationSchemaOptions) (result *GetDatabaseConfigurationSchemaResponse, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(getDatabaseConfigurationSchemaOptions, "getDatabaseConfigurationSchemaOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getDatabaseConfigurationSchemaOptions, "getDatabaseConfigurationSchemaOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	err = crn.ValidateID(*getDatabaseConfigurationSchemaOptions.ID)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "crn-validation-error")
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"id": *getDatabaseConfigurationSchemaOptions.ID,
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = cloudDatabases.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(cloudDatabases.Service.Options.URL, `/deployments/{id}/configuration/schema`, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range getDatabaseConfigurationSchemaOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("cloud_databases", "V5", "GetDatabaseConfigurationSchema")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = cloudDatabases.Service.Request(request, &rawResponse)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "getDatabaseConfigurationSchema", getServiceComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalGetDatabaseConfigurationSchemaResponse)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			return
+		}
+		response.Result = result
+	}
+
+	return
+}
+
+// GetDatabaseConfigurationSchemaOptions : The GetDatabaseConfigurationSchema options.
+type GetDatabaseConfigurationSchemaOptions struct {
+	// Deployment ID.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetDatabaseConfigurationSchemaOptions : Instantiate GetDatabaseConfigurationSchemaOptions
+func (*CloudDatabasesV5) NewGetDatabaseConfigurationSchemaOptions(id string) *GetDatabaseConfigurationSchemaOptions {
+	return &GetDatabaseConfigurationSchemaOptions{
+		ID: core.StringPtr(id),
+	}
+}
+
+// SetID : Allow user to set ID
+func (_options *GetDatabaseConfigurationSchemaOptions) SetID(id string) *GetDatabaseConfigurationSchemaOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetDatabaseConfigurationSchemaOptions) SetHeaders(param map[string]string) *GetDatabaseConfigurationSchemaOptions {
+	options.Headers = param
+	return options
+}
+
+// GetDatabaseConfigurationSchemaResponse : GetDatabaseConfigurationSchemaResponse struct
+type GetDatabaseConfigurationSchemaResponse struct {
+	// The configuration settings of the database, by name, for example "max_connections".
+	Schema map[string]ConfigurationSchemaSetting `json:"schema,omitempty"`
+}
+
+// UnmarshalGetDatabaseConfigurationSchemaResponse unmarshals an instance of GetDatabaseConfigurationSchemaResponse from the specified map of raw messages.
+func UnmarshalGetDatabaseConfigurationSchemaResponse(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(GetDatabaseConfigurationSchemaResponse)
+	err = core.UnmarshalPrimitive(m, "schema", &obj.Schema)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "schema-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// ConfigurationSchemaSetting : A configuration setting of the database.
+type ConfigurationSchemaSetting struct {
+	// The kind of values of the setting, such as "integer", "boolean", "choice" or "string".
+	Kind *string `json:"kind,omitempty"`
+
+	// The description of the setting.
+	Description *string `json:"description,omitempty"`
+
+	// Whether the setting can be changed with UpdateDatabaseConfiguration.
+	CustomerConfigurable *bool `json:"customer_configurable,omitempty"`
+
+	// Whether changing the setting restarts the database.
+	RequiresRestart *bool `json:"requires_restart,omitempty"`
+
+	// The current value of the setting on the deployment, as decoded from JSON. Like the schema operation itself, this
+	// key is not described by the API definition the client is generated from, so it may be missing; the current value
+	// is then unknown, and is not Default.
+	Value interface{} `json:"value,omitempty"`
+
+	// The default value of the setting, as decoded from JSON.
+	Default interface{} `json:"default,omitempty"`
+
+	// The description of the default value.
+	DefaultDescription *string `json:"default_description,omitempty"`
+
+	// The smallest value of an integer setting.
+	Min *int64 `json:"min,omitempty"`
+
+	// The largest value of an integer setting.
+	Max *int64 `json:"max,omitempty"`
+
+	// The increment between the values of an integer setting.
+	Step *int64 `json:"step,omitempty"`
+
+	// The values allowed for a choice setting.
+	Choices []string `json:"choices,omitempty"`
+}
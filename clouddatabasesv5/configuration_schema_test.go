@@ -0,0 +1,80 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GetDatabaseConfigurationSchema`, func() {
+	const deploymentID = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/abc123:d1a2b3c4-0000-4000-8000-000000000001::"
+
+	It(`Get the configuration schema of a deployment`, func() {
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			Expect(req.URL.Path).To(Equal("/deployments/" + deploymentID + "/configuration/schema"))
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"schema": {"max_connections": {"kind": "integer", "customer_configurable": true, "requires_restart": true, "value": 200, "default": 115, "min": 115, "max": 5000, "step": 1}, "wal_level": {"kind": "choice", "value": "replica", "choices": ["replica", "logical"]}}}`)
+		}))
+		defer server.Close()
+
+		cloudDatabasesService, err := clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		result, response, err := cloudDatabasesService.GetDatabaseConfigurationSchema(cloudDatabasesService.NewGetDatabaseConfigurationSchemaOptions(deploymentID))
+		Expect(err).To(BeNil())
+		Expect(response).ToNot(BeNil())
+		Expect(result.Schema).To(HaveLen(2))
+
+		maxConnections := result.Schema["max_connections"]
+		Expect(*maxConnections.Kind).To(Equal("integer"))
+		Expect(*maxConnections.RequiresRestart).To(BeTrue())
+		Expect(maxConnections.Value).To(Equal(float64(200)))
+		Expect(*maxConnections.Min).To(Equal(int64(115)))
+		Expect(result.Schema["wal_level"].Choices).To(Equal([]string{"replica", "logical"}))
+	})
+	It(`Invoke GetDatabaseConfigurationSchema with error: Operation validation and request error`, func() {
+		cloudDatabasesService, err := clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           "http://cloud-databases.test",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		result, response, err := cloudDatabasesService.GetDatabaseConfigurationSchema(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(response).To(BeNil())
+		Expect(result).To(BeNil())
+
+		result, response, err = cloudDatabasesService.GetDatabaseConfigurationSchema(new(clouddatabasesv5.GetDatabaseConfigurationSchemaOptions))
+		Expect(err).ToNot(BeNil())
+		Expect(response).To(BeNil())
+		Expect(result).To(BeNil())
+	})
+})
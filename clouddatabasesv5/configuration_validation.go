@@ -0,0 +1,288 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ConfigurationViolation : A configuration field whose value is out of its documented range or is not one of its
+// allowed values.
+type ConfigurationViolation struct {
+	// The name of the field as sent to the service, for example "max_connections".
+	Field string
+
+	// The value of the field: an int64 or a string.
+	Value interface{}
+
+	// The smallest value allowed, if any.
+	Min *int64
+
+	// The largest value allowed, if any.
+	Max *int64
+
+	// The values allowed, if the field takes one of a set of values.
+	AllowedValues []string
+}
+
+// String returns the field, its value and what is allowed.
+func (v ConfigurationViolation) String() string {
+	if v.AllowedValues != nil {
+		return fmt.Sprintf("%s: '%v' is not one of %s", v.Field, v.Value, strings.Join(v.AllowedValues, ", "))
+	}
+	switch {
+	case v.Min != nil && v.Max != nil:
+		return fmt.Sprintf("%s: %v is not between %d and %d", v.Field, v.Value, *v.Min, *v.Max)
+	case v.Min != nil:
+		return fmt.Sprintf("%s: %v is less than %d", v.Field, v.Value, *v.Min)
+	default:
+		return fmt.Sprintf("%s: %v is greater than %d", v.Field, v.Value, *v.Max)
+	}
+}
+
+// ConfigurationValidationError : The error returned by the Validate method of a configuration.
+// It matches ErrValidation with errors.Is.
+type ConfigurationValidationError struct {
+	// Every invalid field of the configuration, sorted by field name.
+	Violations []ConfigurationViolation
+}
+
+// Error returns every violation.
+func (e *ConfigurationValidationError) Error() string {
+	violations := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		violations[i] = violation.String()
+	}
+	return "invalid configuration: " + strings.Join(violations, "; ")
+}
+
+// Is returns true if target is ErrValidation.
+func (e *ConfigurationValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// configurationRule is the documented range or the allowed values of a configuration field.
+type configurationRule struct {
+	min     *int64
+	max     *int64
+	allowed []string
+}
+
+func intRange(min int64, max int64) configurationRule {
+	return configurationRule{min: core.Int64Ptr(min), max: core.Int64Ptr(max)}
+}
+
+func intMin(min int64) configurationRule {
+	return configurationRule{min: core.Int64Ptr(min)}
+}
+
+func oneOf(allowed ...string) configurationRule {
+	return configurationRule{allowed: allowed}
+}
+
+// pgConfigurationRules are the rules of the ConfigurationPgConfiguration fields.
+var pgConfigurationRules = map[string]configurationRule{
+	"archive_timeout":            intRange(300, 1073741823),
+	"deadlock_timeout":           intRange(100, 2147483647),
+	"effective_io_concurrency":   intRange(1, 1000),
+	"log_connections":            oneOf(ConfigurationPgConfigurationLogConnectionsOffConst, ConfigurationPgConfigurationLogConnectionsOnConst),
+	"log_disconnections":         oneOf(ConfigurationPgConfigurationLogDisconnectionsOffConst, ConfigurationPgConfigurationLogDisconnectionsOnConst),
+	"log_min_duration_statement": intRange(100, 2147483647),
+	"max_connections":            intMin(115),
+	"max_locks_per_transaction":  intMin(10),
+	"max_prepared_transactions":  intMin(0),
+	"max_replication_slots":      intMin(10),
+	"max_wal_senders":            intMin(12),
+	"shared_buffers":             intMin(16),
+	"synchronous_commit":         oneOf(ConfigurationPgConfigurationSynchronousCommitLocalConst, ConfigurationPgConfigurationSynchronousCommitOffConst),
+	"tcp_keepalives_count":       intRange(0, 2147483647),
+	"tcp_keepalives_idle":        intRange(0, 2147483647),
+	"tcp_keepalives_interval":    intRange(0, 2147483647),
+	"wal_level":                  oneOf(ConfigurationPgConfigurationWalLevelLogicalConst, ConfigurationPgConfigurationWalLevelReplicaConst),
+}
+
+// mySQLConfigurationRules are the rules of the ConfigurationMySQLConfiguration fields.
+var mySQLConfigurationRules = map[string]configurationRule{
+	"default_authentication_plugin": oneOf(
+		ConfigurationMySQLConfigurationDefaultAuthenticationPluginCachingSha2PasswordConst,
+		ConfigurationMySQLConfigurationDefaultAuthenticationPluginMysqlNativePasswordConst,
+		ConfigurationMySQLConfigurationDefaultAuthenticationPluginSha256PasswordConst,
+	),
+	"innodb_buffer_pool_size_percentage": intRange(10, 100),
+	"innodb_flush_log_at_trx_commit":     intRange(0, 2),
+	"innodb_log_buffer_size":             intRange(1048576, 4294967295),
+	"innodb_log_file_size":               intRange(4194304, 274877906900),
+	"innodb_lru_scan_depth":              intRange(128, 2048),
+	"innodb_read_io_threads":             intRange(1, 64),
+	"innodb_write_io_threads":            intRange(1, 64),
+	"max_allowed_packet":                 intRange(1024, 1073741824),
+	"max_connections":                    intRange(100, 200000),
+	"max_prepared_stmt_count":            intRange(0, 4194304),
+	"mysql_max_binlog_age_sec":           intRange(300, 1800),
+	"net_read_timeout":                   intRange(1, 7200),
+	"net_write_timeout":                  intRange(1, 7200),
+	"wait_timeout":                       intRange(1, 31536000),
+}
+
+// redisConfigurationRules are the rules of the ConfigurationRedisConfiguration fields.
+var redisConfigurationRules = map[string]configurationRule{
+	"appendonly": oneOf(ConfigurationRedisConfigurationAppendonlyNoConst, ConfigurationRedisConfigurationAppendonlyYesConst),
+	"maxmemory":  intMin(0),
+	"maxmemory-policy": oneOf(
+		ConfigurationRedisConfigurationMaxmemoryPolicyAllkeysLruConst,
+		ConfigurationRedisConfigurationMaxmemoryPolicyAllkeysRandomConst,
+		ConfigurationRedisConfigurationMaxmemoryPolicyNoevictionConst,
+		ConfigurationRedisConfigurationMaxmemoryPolicyVolatileLruConst,
+		ConfigurationRedisConfigurationMaxmemoryPolicyVolatileRandomConst,
+		ConfigurationRedisConfigurationMaxmemoryPolicyVolatileTTLConst,
+	),
+	"maxmemory-samples":           intMin(0),
+	"stop-writes-on-bgsave-error": oneOf(ConfigurationRedisConfigurationStopWritesOnBgsaveErrorNoConst, ConfigurationRedisConfigurationStopWritesOnBgsaveErrorYesConst),
+}
+
+// configurationRules are the rules of the Configuration fields. A field of several database types, such as
+// max_connections, accepts the values allowed by any of them.
+var configurationRules = mergeConfigurationRules(pgConfigurationRules, mySQLConfigurationRules, redisConfigurationRules)
+
+func mergeConfigurationRules(ruleSets ...map[string]configurationRule) map[string]configurationRule {
+	merged := make(map[string]configurationRule)
+	for _, rules := range ruleSets {
+		for field, rule := range rules {
+			mergedRule, ok := merged[field]
+			if !ok {
+				merged[field] = rule
+				continue
+			}
+			if mergedRule.min != nil && (rule.min == nil || *rule.min < *mergedRule.min) {
+				mergedRule.min = rule.min
+			}
+			if mergedRule.max != nil && (rule.max == nil || *rule.max > *mergedRule.max) {
+				mergedRule.max = rule.max
+			}
+			if mergedRule.allowed != nil {
+				mergedRule.allowed = append(append([]string(nil), mergedRule.allowed...), rule.allowed...)
+			}
+			merged[field] = mergedRule
+		}
+	}
+	return merged
+}
+
+// Validate returns a *ConfigurationValidationError listing every field out of its documented range or not set to one
+// of its allowed values. A field shared by several database types is checked against the values allowed by any of
+// them; use the configuration model of the database type for an exact check.
+func (configuration *Configuration) Validate() error {
+	return validateConfiguration(configuration, configurationRules)
+}
+
+// Validate returns a *ConfigurationValidationError listing every field out of its documented range or not set to one
+// of its allowed values.
+func (configuration *ConfigurationPgConfiguration) Validate() error {
+	return validateConfiguration(configuration, pgConfigurationRules)
+}
+
+// Validate returns a *ConfigurationValidationError listing every field out of its documented range or not set to one
+// of its allowed values.
+func (configuration *ConfigurationMySQLConfiguration) Validate() error {
+	return validateConfiguration(configuration, mySQLConfigurationRules)
+}
+
+// Validate returns a *ConfigurationValidationError listing every field out of its documented range or not set to one
+// of its allowed values.
+func (configuration *ConfigurationRedisConfiguration) Validate() error {
+	return validateConfiguration(configuration, redisConfigurationRules)
+}
+
+// Validate always returns nil: the fields of a RabbitMQ configuration have no documented restrictions.
+func (configuration *ConfigurationRabbitMqConfiguration) Validate() error {
+	return nil
+}
+
+// validateConfiguration checks the fields set in the configuration, as sent to the service, against the rules.
+func validateConfiguration(configuration ConfigurationIntf, rules map[string]configurationRule) error {
+	buf, err := json.Marshal(configuration)
+	if err != nil {
+		return core.SDKErrorf(err, "", "configuration-marshal-error", common.GetComponentInfo())
+	}
+	var values map[string]json.RawMessage
+	err = json.Unmarshal(buf, &values)
+	if err != nil {
+		return core.SDKErrorf(err, "", "configuration-unmarshal-error", common.GetComponentInfo())
+	}
+
+	var violations []ConfigurationViolation
+	for field, value := range values {
+		rule, ok := rules[field]
+		if !ok {
+			continue
+		}
+		if rule.allowed != nil {
+			var s string
+			if json.Unmarshal(value, &s) == nil && !containsString(rule.allowed, s) {
+				violations = append(violations, ConfigurationViolation{Field: field, Value: s, AllowedValues: rule.allowed})
+			}
+			continue
+		}
+		var n int64
+		if json.Unmarshal(value, &n) != nil {
+			continue
+		}
+		if (rule.min != nil && n < *rule.min) || (rule.max != nil && n > *rule.max) {
+			violations = append(violations, ConfigurationViolation{Field: field, Value: n, Min: rule.min, Max: rule.max})
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	sort.Slice(violations, func(i, j int) bool {
+		return violations[i].Field < violations[j].Field
+	})
+	return core.SDKErrorf(&ConfigurationValidationError{Violations: violations}, "", "invalid-configuration", common.GetComponentInfo())
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// validateConfiguration validates the configuration with its Validate method, unless SkipValidation is set. It is
+// called by UpdateDatabaseConfiguration before the request is sent.
+func (updateDatabaseConfigurationOptions *UpdateDatabaseConfigurationOptions) validateConfiguration() error {
+	if updateDatabaseConfigurationOptions.SkipValidation != nil && *updateDatabaseConfigurationOptions.SkipValidation {
+		return nil
+	}
+	if configuration, ok := updateDatabaseConfigurationOptions.Configuration.(interface{ Validate() error }); ok {
+		return configuration.Validate()
+	}
+	return nil
+}
+
+// SetSkipValidation : Allow user to set SkipValidation
+func (_options *UpdateDatabaseConfigurationOptions) SetSkipValidation(skipValidation bool) *UpdateDatabaseConfigurationOptions {
+	_options.SkipValidation = core.BoolPtr(skipValidation)
+	return _options
+}
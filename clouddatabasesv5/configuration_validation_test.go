@@ -0,0 +1,118 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Configuration validation`, func() {
+	violations := func(err error) []clouddatabasesv5.ConfigurationViolation {
+		var validationErr *clouddatabasesv5.ConfigurationValidationError
+		Expect(errors.As(err, &validationErr)).To(BeTrue())
+		return validationErr.Violations
+	}
+
+	It(`Accept documented values`, func() {
+		Expect((&clouddatabasesv5.ConfigurationPgConfiguration{
+			MaxConnections:    core.Int64Ptr(115),
+			SharedBuffers:     core.Int64Ptr(16),
+			TCPKeepalivesIdle: core.Int64Ptr(0),
+			SynchronousCommit: core.StringPtr("local"),
+			WalLevel:          core.StringPtr("logical"),
+		}).Validate()).To(BeNil())
+		Expect((&clouddatabasesv5.ConfigurationMySQLConfiguration{
+			InnodbBufferPoolSizePercentage: core.Int64Ptr(100),
+			DefaultAuthenticationPlugin:    core.StringPtr("caching_sha2_password"),
+			SQLMode:                        core.StringPtr("ANSI"),
+		}).Validate()).To(BeNil())
+		Expect((&clouddatabasesv5.ConfigurationRedisConfiguration{
+			MaxmemorySamples: core.Int64Ptr(5),
+			MaxmemoryPolicy:  core.StringPtr("noeviction"),
+		}).Validate()).To(BeNil())
+		Expect((&clouddatabasesv5.ConfigurationRabbitMqConfiguration{DeleteUndefinedQueues: core.BoolPtr(true)}).Validate()).To(BeNil())
+	})
+	It(`Report every violation`, func() {
+		err := (&clouddatabasesv5.ConfigurationPgConfiguration{
+			MaxConnections:    core.Int64Ptr(10),
+			TCPKeepalivesIdle: core.Int64Ptr(-1),
+			WalLevel:          core.StringPtr("minimal"),
+		}).Validate()
+		Expect(errors.Is(err, clouddatabasesv5.ErrValidation)).To(BeTrue())
+		Expect(violations(err)).To(Equal([]clouddatabasesv5.ConfigurationViolation{
+			{Field: "max_connections", Value: int64(10), Min: core.Int64Ptr(115)},
+			{Field: "tcp_keepalives_idle", Value: int64(-1), Min: core.Int64Ptr(0), Max: core.Int64Ptr(2147483647)},
+			{Field: "wal_level", Value: "minimal", AllowedValues: []string{"logical", "replica"}},
+		}))
+		Expect(err.Error()).To(ContainSubstring("max_connections: 10 is less than 115; tcp_keepalives_idle: -1 is not between 0 and 2147483647; wal_level: 'minimal' is not one of logical, replica"))
+
+		err = (&clouddatabasesv5.ConfigurationMySQLConfiguration{InnodbBufferPoolSizePercentage: core.Int64Ptr(5)}).Validate()
+		Expect(violations(err)).To(Equal([]clouddatabasesv5.ConfigurationViolation{
+			{Field: "innodb_buffer_pool_size_percentage", Value: int64(5), Min: core.Int64Ptr(10), Max: core.Int64Ptr(100)},
+		}))
+
+		err = (&clouddatabasesv5.ConfigurationRedisConfiguration{MaxmemoryPolicy: core.StringPtr("allkeys-lfu")}).Validate()
+		Expect(violations(err)).To(HaveLen(1))
+	})
+	It(`Validate a Configuration against every database type`, func() {
+		// max_connections accepts the values of PostgreSQL (at least 115) and MySQL (100 to 200000).
+		Expect((&clouddatabasesv5.Configuration{MaxConnections: core.Int64Ptr(100)}).Validate()).To(BeNil())
+		Expect((&clouddatabasesv5.Configuration{MaxConnections: core.Int64Ptr(500000)}).Validate()).To(BeNil())
+
+		err := (&clouddatabasesv5.Configuration{
+			MaxConnections:  core.Int64Ptr(50),
+			MaxmemoryPolicy: core.StringPtr("lru"),
+		}).Validate()
+		Expect(violations(err)).To(HaveLen(2))
+		Expect(violations(err)[0].Min).To(Equal(core.Int64Ptr(100)))
+		Expect(violations(err)[0].Max).To(BeNil())
+	})
+	It(`Validate the configuration before updating it`, func() {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests++
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"task": {"id": "task-id", "status": "queued"}}`)
+		}))
+		defer server.Close()
+		cloudDatabasesService, err := clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		updateDatabaseConfigurationOptions := cloudDatabasesService.NewUpdateDatabaseConfigurationOptions("testString")
+		updateDatabaseConfigurationOptions.SetConfiguration(&clouddatabasesv5.ConfigurationPgConfiguration{SharedBuffers: core.Int64Ptr(8)})
+		_, _, err = cloudDatabasesService.UpdateDatabaseConfiguration(updateDatabaseConfigurationOptions)
+		Expect(errors.Is(err, clouddatabasesv5.ErrValidation)).To(BeTrue())
+		Expect(requests).To(Equal(0))
+
+		updateDatabaseConfigurationOptions.SetSkipValidation(true)
+		_, _, err = cloudDatabasesService.UpdateDatabaseConfiguration(updateDatabaseConfigurationOptions)
+		Expect(err).To(BeNil())
+		Expect(requests).To(Equal(1))
+	})
+})
@@ -0,0 +1,179 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+var (
+	// ErrInvalidCertificate is returned when a connection certificate cannot be decoded or parsed.
+	ErrInvalidCertificate = errors.New("invalid connection certificate")
+
+	// ErrCertificateExpired is returned by TLSConfig when every certificate of a connection certificate has expired.
+	ErrCertificateExpired = errors.New("connection certificate has expired")
+)
+
+// PEM returns the decoded PEM encoding of the CA certificate.
+func (certificate *ConnectionCertificate) PEM() ([]byte, error) {
+	if certificate == nil || certificate.CertificateBase64 == nil || *certificate.CertificateBase64 == "" {
+		return nil, certificateError(nil, "no certificate data")
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(*certificate.CertificateBase64))
+	if err != nil {
+		return nil, certificateError(err, "certificate_base64 is not base64")
+	}
+	return data, nil
+}
+
+// Certificates returns the parsed certificates of the PEM encoding. It returns an error if any PEM block fails to
+// parse, or if there are no certificates.
+func (certificate *ConnectionCertificate) Certificates() ([]*x509.Certificate, error) {
+	data, err := certificate.PEM()
+	if err != nil {
+		return nil, err
+	}
+
+	var certificates []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		parsed, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, certificateError(err, "certificate does not parse")
+		}
+		certificates = append(certificates, parsed)
+	}
+	if len(certificates) == 0 {
+		return nil, certificateError(nil, "no PEM encoded certificates")
+	}
+	return certificates, nil
+}
+
+// CertPool returns a pool holding the CA certificate.
+func (certificate *ConnectionCertificate) CertPool() (*x509.CertPool, error) {
+	certificates, err := certificate.Certificates()
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	for _, parsed := range certificates {
+		pool.AddCert(parsed)
+	}
+	return pool, nil
+}
+
+// Expiry returns the time after which the CA certificate is no longer valid. When the PEM encoding holds several
+// certificates, it returns the latest expiry.
+func (certificate *ConnectionCertificate) Expiry() (time.Time, error) {
+	certificates, err := certificate.Certificates()
+	if err != nil {
+		return time.Time{}, err
+	}
+	var expiry time.Time
+	for _, parsed := range certificates {
+		if parsed.NotAfter.After(expiry) {
+			expiry = parsed.NotAfter
+		}
+	}
+	return expiry, nil
+}
+
+// TLSConfig returns a TLS configuration that trusts only the CA certificate and verifies the server against
+// serverName, which is normally the hostname of the connection. It returns an error wrapping ErrCertificateExpired
+// if the CA certificate has expired.
+func (certificate *ConnectionCertificate) TLSConfig(serverName string) (*tls.Config, error) {
+	expiry, err := certificate.Expiry()
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().After(expiry) {
+		err = fmt.Errorf("%w on %s", ErrCertificateExpired, expiry.Format(time.RFC3339))
+		return nil, core.SDKErrorf(err, "", "certificate-expired", common.GetComponentInfo())
+	}
+
+	pool, err := certificate.CertPool()
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		RootCAs:    pool,
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
+
+// WriteTempFile writes the PEM encoding of the CA certificate to a new file in dir, or in the default directory for
+// temporary files when dir is empty, and returns its path. The path suits tools that take the CA as a file, such as
+// psql through PGSSLROOTCERT. The caller is responsible for removing the file.
+func (certificate *ConnectionCertificate) WriteTempFile(dir string) (path string, err error) {
+	_, err = certificate.Certificates()
+	if err != nil {
+		return
+	}
+	data, err := certificate.PEM()
+	if err != nil {
+		return
+	}
+
+	pattern := "cloud-databases-ca-*.pem"
+	if certificate.Name != nil && *certificate.Name != "" {
+		pattern = strings.ReplaceAll(*certificate.Name, string(os.PathSeparator), "_") + "-*.pem"
+	}
+	file, err := os.CreateTemp(dir, pattern)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "certificate-file-error", common.GetComponentInfo())
+		return
+	}
+	path = file.Name()
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		path = ""
+		err = core.SDKErrorf(err, "", "certificate-file-error", common.GetComponentInfo())
+	}
+	return
+}
+
+func certificateError(err error, reason string) error {
+	if err != nil {
+		err = fmt.Errorf("%w: %s: %s", ErrInvalidCertificate, reason, err.Error())
+	} else {
+		err = fmt.Errorf("%w: %s", ErrInvalidCertificate, reason)
+	}
+	return core.SDKErrorf(err, "", "invalid-certificate", common.GetComponentInfo())
+}
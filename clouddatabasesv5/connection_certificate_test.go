@@ -0,0 +1,120 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// selfSignedCA returns the base64 encoded PEM of a new self-signed CA certificate valid until notAfter.
+func selfSignedCA(notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).To(BeNil())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Cloud Databases test CA"},
+		NotBefore:             notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).To(BeNil())
+	return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+var _ = Describe(`ConnectionCertificate`, func() {
+	It(`Build a TLS config that pins the CA`, func() {
+		notAfter := time.Now().Add(24 * time.Hour).Truncate(time.Second).UTC()
+		certificate := &clouddatabasesv5.ConnectionCertificate{
+			Name:              core.StringPtr("b5c0ad3c-1e6c-11e9-a5b3-ee2d6aa5c1a3"),
+			CertificateBase64: core.StringPtr(selfSignedCA(notAfter)),
+		}
+
+		tlsConfig, err := certificate.TLSConfig("host-0.databases.appdomain.cloud")
+		Expect(err).To(BeNil())
+		Expect(tlsConfig.ServerName).To(Equal("host-0.databases.appdomain.cloud"))
+		Expect(tlsConfig.RootCAs).ToNot(BeNil())
+		Expect(tlsConfig.MinVersion).To(Equal(uint16(tls.VersionTLS12)))
+
+		expiry, err := certificate.Expiry()
+		Expect(err).To(BeNil())
+		Expect(expiry).To(Equal(notAfter))
+	})
+	It(`Write the PEM to a temporary file`, func() {
+		certificate := &clouddatabasesv5.ConnectionCertificate{
+			Name:              core.StringPtr("b5c0ad3c-1e6c-11e9-a5b3-ee2d6aa5c1a3"),
+			CertificateBase64: core.StringPtr(selfSignedCA(time.Now().Add(time.Hour))),
+		}
+		dir, err := os.MkdirTemp("", "certificates")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+
+		path, err := certificate.WriteTempFile(dir)
+		Expect(err).To(BeNil())
+		Expect(filepath.Dir(path)).To(Equal(dir))
+		Expect(filepath.Base(path)).To(HavePrefix("b5c0ad3c-1e6c-11e9-a5b3-ee2d6aa5c1a3-"))
+
+		written, err := os.ReadFile(path)
+		Expect(err).To(BeNil())
+		data, err := certificate.PEM()
+		Expect(err).To(BeNil())
+		Expect(written).To(Equal(data))
+	})
+	It(`Return an error for an expired CA`, func() {
+		certificate := &clouddatabasesv5.ConnectionCertificate{
+			CertificateBase64: core.StringPtr(selfSignedCA(time.Now().Add(-time.Hour))),
+		}
+		tlsConfig, err := certificate.TLSConfig("host")
+		Expect(tlsConfig).To(BeNil())
+		Expect(errors.Is(err, clouddatabasesv5.ErrCertificateExpired)).To(BeTrue())
+	})
+	It(`Flag certificates that fail to decode or parse`, func() {
+		invalid := []*clouddatabasesv5.ConnectionCertificate{
+			nil,
+			{},
+			{CertificateBase64: core.StringPtr("not base64!")},
+			{CertificateBase64: core.StringPtr(base64.StdEncoding.EncodeToString([]byte("no PEM here")))},
+			{CertificateBase64: core.StringPtr(base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("garbage")})))},
+		}
+		for _, certificate := range invalid {
+			_, err := certificate.TLSConfig("host")
+			Expect(errors.Is(err, clouddatabasesv5.ErrInvalidCertificate)).To(BeTrue())
+			path, err := certificate.WriteTempFile("")
+			Expect(path).To(BeEmpty())
+			Expect(errors.Is(err, clouddatabasesv5.ErrInvalidCertificate)).To(BeTrue())
+		}
+	})
+})
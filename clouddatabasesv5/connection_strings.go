@@ -0,0 +1,448 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PasswordPlaceholder is the placeholder for the password in the composed connection strings of a connection.
+const PasswordPlaceholder = "$PASSWORD"
+
+// Constants for the ConnectionStringOptions.Endpoint property. Each names an endpoint of a connection.
+const (
+	ConnectionEndpointAmqpsConst       = "amqps"
+	ConnectionEndpointAnalyticsConst   = "analytics"
+	ConnectionEndpointBiConnectorConst = "bi_connector"
+	ConnectionEndpointEmpConst         = "emp"
+	ConnectionEndpointGrpcConst        = "grpc"
+	ConnectionEndpointHTTPSConst       = "https"
+	ConnectionEndpointMongodbConst     = "mongodb"
+	ConnectionEndpointMqttsConst       = "mqtts"
+	ConnectionEndpointMysqlConst       = "mysql"
+	ConnectionEndpointOpsManagerConst  = "ops_manager"
+	ConnectionEndpointPostgresConst    = "postgres"
+	ConnectionEndpointRedissConst      = "rediss"
+	ConnectionEndpointStompSslConst    = "stomp_ssl"
+)
+
+// ConnectionStringOptions : The inputs for building a driver connection string from a connection returned by
+// GetConnection.
+type ConnectionStringOptions struct {
+	// The password of the user, which replaces the password placeholder. Use the password from the connection when
+	// empty.
+	Password string
+
+	// The endpoint of the connection to use. Defaults to the native endpoint of the database, for example "postgres".
+	Endpoint string
+
+	// The index of the host to use for drivers that take a single host. Defaults to the first host.
+	HostIndex int
+
+	// The path of a file holding the CA certificate of the deployment, for drivers that take a path.
+	CAFile string
+
+	// The name of the TLS configuration registered with the MySQL driver (mysql.RegisterTLSConfig). Defaults to
+	// "true", which verifies the server against the system CA certificates.
+	MySQLTLSConfig string
+}
+
+// RedisOptions : The fields of a go-redis redis.Options for connecting to a deployment.
+type RedisOptions struct {
+	// Host and port of the server, as host:port.
+	Addr string
+
+	Username string
+
+	Password string
+
+	// Number of the database to select.
+	DB int
+
+	// Indicates TLS is required for the connection. The go-redis TLSConfig must be set when true.
+	TLS bool
+
+	// Server name to verify the certificate of the server against.
+	ServerName string
+}
+
+// connectionEndpoint : The fields common to the URI endpoints of a connection.
+type connectionEndpoint struct {
+	composed       []string
+	scheme         string
+	hosts          []ConnectionHost
+	path           string
+	queryOptions   map[string]interface{}
+	authentication *ConnectionAuthentication
+	ssl            bool
+	database       string
+	replicaSet     string
+}
+
+// PostgresConnectionString returns a lib/pq keyword/value connection string for a PostgreSQL or EnterpriseDB
+// connection.
+func PostgresConnectionString(connection ConnectionIntf, connectionStringOptions *ConnectionStringOptions) (string, error) {
+	endpoint, options, err := selectEndpoint(connection, connectionStringOptions, ConnectionEndpointPostgresConst)
+	if err != nil {
+		return "", err
+	}
+	host, err := selectHost(endpoint, options)
+	if err != nil {
+		return "", err
+	}
+
+	username, password := credentials(endpoint, options)
+	params := [][2]string{
+		{"host", core.StringNilMapper(host.Hostname)},
+		{"port", portOf(host)},
+		{"user", username},
+		{"password", password},
+		{"dbname", endpoint.database},
+	}
+	sslmode := fmt.Sprint(endpoint.queryOptions["sslmode"])
+	if _, ok := endpoint.queryOptions["sslmode"]; !ok {
+		sslmode = "disable"
+		if endpoint.ssl {
+			sslmode = "verify-full"
+		}
+	}
+	params = append(params, [2]string{"sslmode", sslmode})
+	if options.CAFile != "" {
+		params = append(params, [2]string{"sslrootcert", options.CAFile})
+	}
+
+	var dsn []string
+	for _, param := range params {
+		if param[1] != "" {
+			dsn = append(dsn, param[0]+"="+quotePostgresValue(param[1]))
+		}
+	}
+	return strings.Join(dsn, " "), nil
+}
+
+// MySQLConnectionString returns a go-sql-driver/mysql DSN for a MySQL connection.
+func MySQLConnectionString(connection ConnectionIntf, connectionStringOptions *ConnectionStringOptions) (string, error) {
+	endpoint, options, err := selectEndpoint(connection, connectionStringOptions, ConnectionEndpointMysqlConst)
+	if err != nil {
+		return "", err
+	}
+	host, err := selectHost(endpoint, options)
+	if err != nil {
+		return "", err
+	}
+
+	username, password := credentials(endpoint, options)
+	if strings.ContainsAny(username, ":@/") {
+		return "", core.SDKErrorf(nil, fmt.Sprintf("username '%s' cannot be used in a MySQL DSN", username), "invalid-username", common.GetComponentInfo())
+	}
+	dsn := fmt.Sprintf("%s:%s@tcp(%s)/%s", username, password, hostPort(host), endpoint.database)
+	if endpoint.ssl {
+		tlsConfig := options.MySQLTLSConfig
+		if tlsConfig == "" {
+			tlsConfig = "true"
+		}
+		dsn += "?tls=" + escapeComponent(tlsConfig)
+	}
+	return dsn, nil
+}
+
+// MongoDbConnectionString returns a mongodb:// URI listing every host of a MongoDB connection.
+func MongoDbConnectionString(connection ConnectionIntf, connectionStringOptions *ConnectionStringOptions) (string, error) {
+	endpoint, options, err := selectEndpoint(connection, connectionStringOptions, ConnectionEndpointMongodbConst)
+	if err != nil {
+		return "", err
+	}
+	if len(endpoint.hosts) == 0 {
+		return "", core.SDKErrorf(nil, "connection endpoint has no hosts", "missing-host", common.GetComponentInfo())
+	}
+
+	var hosts []string
+	for i := range endpoint.hosts {
+		hosts = append(hosts, hostPort(&endpoint.hosts[i]))
+	}
+	username, password := credentials(endpoint, options)
+	query := map[string]string{}
+	for name, value := range endpoint.queryOptions {
+		query[name] = fmt.Sprint(value)
+	}
+	if endpoint.replicaSet != "" {
+		query["replicaSet"] = endpoint.replicaSet
+	}
+	if endpoint.ssl {
+		query["tls"] = "true"
+	}
+	if options.CAFile != "" {
+		query["tlsCAFile"] = options.CAFile
+	}
+
+	scheme := endpoint.scheme
+	if scheme == "" {
+		scheme = "mongodb"
+	}
+	uri := fmt.Sprintf("%s://%s:%s@%s/%s", scheme, escapeComponent(username), escapeComponent(password), strings.Join(hosts, ","), escapeComponent(endpoint.database))
+	if len(query) > 0 {
+		uri += "?" + encodeQuery(query)
+	}
+	return uri, nil
+}
+
+// RedisConnectionOptions returns the go-redis options for a Redis connection.
+func RedisConnectionOptions(connection ConnectionIntf, connectionStringOptions *ConnectionStringOptions) (*RedisOptions, error) {
+	endpoint, options, err := selectEndpoint(connection, connectionStringOptions, ConnectionEndpointRedissConst)
+	if err != nil {
+		return nil, err
+	}
+	host, err := selectHost(endpoint, options)
+	if err != nil {
+		return nil, err
+	}
+
+	username, password := credentials(endpoint, options)
+	redisOptions := &RedisOptions{
+		Addr:     hostPort(host),
+		Username: username,
+		Password: password,
+		TLS:      endpoint.ssl || endpoint.scheme == "rediss",
+	}
+	if redisOptions.TLS {
+		redisOptions.ServerName = core.StringNilMapper(host.Hostname)
+	}
+	if endpoint.database != "" {
+		redisOptions.DB, err = strconv.Atoi(endpoint.database)
+		if err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("invalid Redis database '%s'", endpoint.database), "invalid-database", common.GetComponentInfo())
+		}
+	}
+	return redisOptions, nil
+}
+
+// ComposedConnectionString returns the first composed connection string of an endpoint with the password placeholder
+// replaced by the escaped password. It suits drivers that take a URI, such as amqps or https endpoints.
+func ComposedConnectionString(connection ConnectionIntf, connectionStringOptions *ConnectionStringOptions) (string, error) {
+	endpoint, options, err := selectEndpoint(connection, connectionStringOptions, "")
+	if err != nil {
+		return "", err
+	}
+	if len(endpoint.composed) == 0 {
+		return "", core.SDKErrorf(nil, "connection endpoint has no composed connection string", "missing-composed", common.GetComponentInfo())
+	}
+	_, password := credentials(endpoint, options)
+	return strings.ReplaceAll(endpoint.composed[0], PasswordPlaceholder, escapeComponent(password)), nil
+}
+
+// selectEndpoint returns the endpoint of the connection named in the options, or the default endpoint.
+func selectEndpoint(connection ConnectionIntf, connectionStringOptions *ConnectionStringOptions, defaultEndpoint string) (*connectionEndpoint, *ConnectionStringOptions, error) {
+	if connectionStringOptions == nil {
+		connectionStringOptions = &ConnectionStringOptions{}
+	}
+	endpoints, err := connectionEndpoints(connection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	name := connectionStringOptions.Endpoint
+	if name == "" {
+		name = defaultEndpoint
+	}
+	if name == "" {
+		for _, candidate := range []string{
+			ConnectionEndpointPostgresConst,
+			ConnectionEndpointRedissConst,
+			ConnectionEndpointMysqlConst,
+			ConnectionEndpointMongodbConst,
+			ConnectionEndpointAmqpsConst,
+			ConnectionEndpointHTTPSConst,
+			ConnectionEndpointGrpcConst,
+			ConnectionEndpointOpsManagerConst,
+		} {
+			if endpoints[candidate] != nil {
+				name = candidate
+				break
+			}
+		}
+	}
+	endpoint := endpoints[name]
+	if endpoint == nil {
+		return nil, nil, core.SDKErrorf(nil, fmt.Sprintf("connection has no '%s' endpoint", name), "missing-endpoint", common.GetComponentInfo())
+	}
+	return endpoint, connectionStringOptions, nil
+}
+
+// connectionEndpoints returns the URI endpoints of a connection, keyed by their JSON names.
+func connectionEndpoints(connection ConnectionIntf) (map[string]*connectionEndpoint, error) {
+	generic := &Connection{}
+	switch c := connection.(type) {
+	case *Connection:
+		if c != nil {
+			generic = c
+		}
+	case *ConnectionPostgreSQLConnection:
+		generic.Postgres = c.Postgres
+	case *ConnectionEnterpriseDbConnection:
+		generic.Postgres, generic.Emp = c.Postgres, c.Emp
+	case *ConnectionRedisConnection:
+		generic.Rediss = c.Rediss
+	case *ConnectionMySQLConnection:
+		generic.Mysql = c.Mysql
+	case *ConnectionMongoDbConnection:
+		generic.Mongodb = c.Mongodb
+	case *ConnectionMongoDbeeConnection:
+		generic.Mongodb, generic.BiConnector, generic.Analytics = c.Mongodb, c.BiConnector, c.Analytics
+	case *ConnectionMongoDbeeOpsManagerConnection:
+		generic.OpsManager = c.OpsManager
+	case *ConnectionElasticsearchConnection:
+		generic.HTTPS = c.HTTPS
+	case *ConnectionRabbitMqConnection:
+		generic.Amqps, generic.Mqtts, generic.StompSsl, generic.HTTPS = c.Amqps, c.Mqtts, c.StompSsl, c.HTTPS
+	case *ConnectionEtcdConnection:
+		generic.Grpc = c.Grpc
+	default:
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("unsupported connection type %T", connection), "unsupported-connection", common.GetComponentInfo())
+	}
+
+	endpoints := map[string]*connectionEndpoint{}
+	if uri := generic.Postgres; uri != nil {
+		endpoint := newConnectionEndpoint(uri.Composed, uri.Scheme, uri.Hosts, uri.Path, uri.QueryOptions, uri.Authentication, uri.Ssl)
+		endpoint.database = core.StringNilMapper(uri.Database)
+		endpoints[ConnectionEndpointPostgresConst] = endpoint
+	}
+	if uri := generic.Mysql; uri != nil {
+		endpoint := newConnectionEndpoint(uri.Composed, uri.Scheme, uri.Hosts, uri.Path, uri.QueryOptions, uri.Authentication, uri.Ssl)
+		endpoint.database = core.StringNilMapper(uri.Database)
+		endpoints[ConnectionEndpointMysqlConst] = endpoint
+	}
+	if uri := generic.Mongodb; uri != nil {
+		endpoint := newConnectionEndpoint(uri.Composed, uri.Scheme, uri.Hosts, uri.Path, uri.QueryOptions, uri.Authentication, uri.Ssl)
+		endpoint.database = core.StringNilMapper(uri.Database)
+		endpoint.replicaSet = core.StringNilMapper(uri.ReplicaSet)
+		endpoints[ConnectionEndpointMongodbConst] = endpoint
+	}
+	if uri := generic.Rediss; uri != nil {
+		endpoint := newConnectionEndpoint(uri.Composed, uri.Scheme, uri.Hosts, uri.Path, uri.QueryOptions, uri.Authentication, uri.Ssl)
+		if uri.Database != nil {
+			endpoint.database = strconv.FormatInt(*uri.Database, 10)
+		}
+		endpoints[ConnectionEndpointRedissConst] = endpoint
+	}
+	for name, uri := range map[string]*ConnectionURI{
+		ConnectionEndpointAmqpsConst:       generic.Amqps,
+		ConnectionEndpointAnalyticsConst:   generic.Analytics,
+		ConnectionEndpointBiConnectorConst: generic.BiConnector,
+		ConnectionEndpointEmpConst:         generic.Emp,
+		ConnectionEndpointGrpcConst:        generic.Grpc,
+		ConnectionEndpointHTTPSConst:       generic.HTTPS,
+		ConnectionEndpointMqttsConst:       generic.Mqtts,
+		ConnectionEndpointOpsManagerConst:  generic.OpsManager,
+		ConnectionEndpointStompSslConst:    generic.StompSsl,
+	} {
+		if uri != nil {
+			endpoint := newConnectionEndpoint(uri.Composed, uri.Scheme, uri.Hosts, uri.Path, uri.QueryOptions, uri.Authentication, uri.Ssl)
+			endpoint.database = strings.TrimPrefix(endpoint.path, "/")
+			endpoints[name] = endpoint
+		}
+	}
+	return endpoints, nil
+}
+
+func newConnectionEndpoint(composed []string, scheme *string, hosts []ConnectionHost, path *string, queryOptions map[string]interface{}, authentication *ConnectionAuthentication, ssl *bool) *connectionEndpoint {
+	return &connectionEndpoint{
+		composed:       composed,
+		scheme:         core.StringNilMapper(scheme),
+		hosts:          hosts,
+		path:           core.StringNilMapper(path),
+		queryOptions:   queryOptions,
+		authentication: authentication,
+		ssl:            ssl != nil && *ssl,
+	}
+}
+
+// selectHost returns the host of the endpoint at the index named in the options.
+func selectHost(endpoint *connectionEndpoint, options *ConnectionStringOptions) (*ConnectionHost, error) {
+	if options.HostIndex < 0 || options.HostIndex >= len(endpoint.hosts) {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("connection endpoint has no host at index %d", options.HostIndex), "missing-host", common.GetComponentInfo())
+	}
+	return &endpoint.hosts[options.HostIndex], nil
+}
+
+// credentials returns the username of the endpoint and the password from the options, falling back to the password
+// of the endpoint.
+func credentials(endpoint *connectionEndpoint, options *ConnectionStringOptions) (username string, password string) {
+	if endpoint.authentication != nil {
+		username = core.StringNilMapper(endpoint.authentication.Username)
+		password = core.StringNilMapper(endpoint.authentication.Password)
+	}
+	if options.Password != "" {
+		password = options.Password
+	}
+	return
+}
+
+func hostPort(host *ConnectionHost) string {
+	return net.JoinHostPort(core.StringNilMapper(host.Hostname), portOf(host))
+}
+
+func portOf(host *ConnectionHost) string {
+	if host.Port == nil {
+		return ""
+	}
+	return strconv.FormatInt(*host.Port, 10)
+}
+
+// quotePostgresValue quotes a value of a keyword/value connection string when it is empty or contains whitespace,
+// quotes or backslashes.
+func quotePostgresValue(value string) string {
+	if value != "" && !strings.ContainsAny(value, " \t\n\r\f\v'\\") {
+		return value
+	}
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+// escapeComponent percent-encodes every byte of s outside the unreserved characters of RFC 3986, so the result is
+// safe in the userinfo, path and query of any URI.
+func escapeComponent(s string) string {
+	var escaped strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-' || c == '.' || c == '_' || c == '~' {
+			escaped.WriteByte(c)
+		} else {
+			fmt.Fprintf(&escaped, "%%%02X", c)
+		}
+	}
+	return escaped.String()
+}
+
+// encodeQuery encodes query parameters in name order.
+func encodeQuery(query map[string]string) string {
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	params := make([]string, 0, len(names))
+	for _, name := range names {
+		params = append(params, escapeComponent(name)+"="+escapeComponent(query[name]))
+	}
+	return strings.Join(params, "&")
+}
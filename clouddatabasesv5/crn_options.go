@@ -0,0 +1,196 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"github.com/IBM/cloud-databases-go-sdk/crn"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *AddAllowlistEntryOptions) SetCRN(deploymentCRN *crn.CRN) *AddAllowlistEntryOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *CompleteConnectionOptions) SetCRN(deploymentCRN *crn.CRN) *CompleteConnectionOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *CreateDatabaseUserOptions) SetCRN(deploymentCRN *crn.CRN) *CreateDatabaseUserOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *CreateLogicalReplicationSlotOptions) SetCRN(deploymentCRN *crn.CRN) *CreateLogicalReplicationSlotOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *DeleteAllowlistEntryOptions) SetCRN(deploymentCRN *crn.CRN) *DeleteAllowlistEntryOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *DeleteDatabaseUserOptions) SetCRN(deploymentCRN *crn.CRN) *DeleteDatabaseUserOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *DeleteLogicalReplicationSlotOptions) SetCRN(deploymentCRN *crn.CRN) *DeleteLogicalReplicationSlotOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *GetAllowlistOptions) SetCRN(deploymentCRN *crn.CRN) *GetAllowlistOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *GetAutoscalingConditionsOptions) SetCRN(deploymentCRN *crn.CRN) *GetAutoscalingConditionsOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set BackupID from the parsed CRN of the backup
+func (_options *GetBackupInfoOptions) SetCRN(backupCRN *crn.CRN) *GetBackupInfoOptions {
+	_options.BackupID = core.StringPtr(backupCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *GetConnectionOptions) SetCRN(deploymentCRN *crn.CRN) *GetConnectionOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *GetDeploymentCapabilityOptions) SetCRN(deploymentCRN *crn.CRN) *GetDeploymentCapabilityOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *GetDeploymentInfoOptions) SetCRN(deploymentCRN *crn.CRN) *GetDeploymentInfoOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *GetPitrDataOptions) SetCRN(deploymentCRN *crn.CRN) *GetPitrDataOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the task
+func (_options *GetTaskOptions) SetCRN(taskCRN *crn.CRN) *GetTaskOptions {
+	_options.ID = core.StringPtr(taskCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *KillConnectionsOptions) SetCRN(deploymentCRN *crn.CRN) *KillConnectionsOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *ListDeploymentBackupsOptions) SetCRN(deploymentCRN *crn.CRN) *ListDeploymentBackupsOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *ListDeploymentScalingGroupsOptions) SetCRN(deploymentCRN *crn.CRN) *ListDeploymentScalingGroupsOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *ListDeploymentTasksOptions) SetCRN(deploymentCRN *crn.CRN) *ListDeploymentTasksOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *ListRemotesOptions) SetCRN(deploymentCRN *crn.CRN) *ListRemotesOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *PromoteReadOnlyReplicaOptions) SetCRN(deploymentCRN *crn.CRN) *PromoteReadOnlyReplicaOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *ResyncReplicaOptions) SetCRN(deploymentCRN *crn.CRN) *ResyncReplicaOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *SetAllowlistOptions) SetCRN(deploymentCRN *crn.CRN) *SetAllowlistOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *SetAutoscalingConditionsOptions) SetCRN(deploymentCRN *crn.CRN) *SetAutoscalingConditionsOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *SetDatabaseInplaceVersionUpgradeOptions) SetCRN(deploymentCRN *crn.CRN) *SetDatabaseInplaceVersionUpgradeOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *SetDeploymentScalingGroupOptions) SetCRN(deploymentCRN *crn.CRN) *SetDeploymentScalingGroupOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *StartOndemandBackupOptions) SetCRN(deploymentCRN *crn.CRN) *StartOndemandBackupOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *UpdateDatabaseConfigurationOptions) SetCRN(deploymentCRN *crn.CRN) *UpdateDatabaseConfigurationOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
+
+// SetCRN : Allow user to set ID from the parsed CRN of the deployment
+func (_options *UpdateUserOptions) SetCRN(deploymentCRN *crn.CRN) *UpdateUserOptions {
+	_options.ID = core.StringPtr(deploymentCRN.String())
+	return _options
+}
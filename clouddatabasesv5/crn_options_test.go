@@ -0,0 +1,85 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/crn"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Deployment CRNs`, func() {
+	const deploymentCRN = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/274074dce64e9c423ffc238516c755e1:29caf0e7-120f-4da8-9551-3abf57ebcfc7::"
+
+	var testServer *httptest.Server
+	var cloudDatabasesService *clouddatabasesv5.CloudDatabasesV5
+	var requestPaths []string
+
+	BeforeEach(func() {
+		requestPaths = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requestPaths = append(requestPaths, req.URL.EscapedPath())
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "%s", `{"deployment": {"id": "`+deploymentCRN+`"}}`)
+		}))
+		var serviceErr error
+		cloudDatabasesService, serviceErr = clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Set the ID of options from a parsed CRN`, func() {
+		getDeploymentInfoOptions := cloudDatabasesService.NewGetDeploymentInfoOptions("")
+		getDeploymentInfoOptions.SetCRN(crn.MustParse(deploymentCRN))
+		Expect(*getDeploymentInfoOptions.ID).To(Equal(deploymentCRN))
+
+		result, _, err := cloudDatabasesService.GetDeploymentInfo(getDeploymentInfoOptions)
+		Expect(err).To(BeNil())
+		Expect(*result.Deployment.ID).To(Equal(deploymentCRN))
+		Expect(requestPaths).To(Equal([]string{"/deployments/" + crn.MustParse(deploymentCRN).PathEscape()}))
+	})
+	It(`Reject a malformed CRN without sending a request`, func() {
+		result, response, err := cloudDatabasesService.GetDeploymentInfo(cloudDatabasesService.NewGetDeploymentInfoOptions("crn:v1:bluemix:public:databases-for-postgresql"))
+		Expect(result).To(BeNil())
+		Expect(response).To(BeNil())
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, crn.ErrInvalidCRN)).To(BeTrue())
+		Expect(requestPaths).To(BeEmpty())
+
+		_, _, err = cloudDatabasesService.GetBackupInfo(cloudDatabasesService.NewGetBackupInfoOptions("crn:v2:bluemix:public:databases-for-postgresql:us-south:a/1:guid:backup:1"))
+		Expect(errors.Is(err, crn.ErrInvalidCRN)).To(BeTrue())
+		Expect(requestPaths).To(BeEmpty())
+	})
+	It(`Accept deployment IDs that are not CRNs`, func() {
+		_, _, err := cloudDatabasesService.GetDeploymentInfo(cloudDatabasesService.NewGetDeploymentInfoOptions("3c1b5ee7-1f5e-4d6a-9f2c-2b0c8c0a4f7e"))
+		Expect(err).To(BeNil())
+		Expect(requestPaths).To(HaveLen(1))
+	})
+})
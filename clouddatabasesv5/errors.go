@@ -0,0 +1,275 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Classes of errors returned by the service. An operation that fails with an error response returns an *APIError,
+// which matches the class of the response with errors.Is:
+//
+//	_, _, err := cloudDatabasesService.GetDeploymentInfo(getDeploymentInfoOptions)
+//	if errors.Is(err, clouddatabasesv5.ErrDeploymentNotFound) {
+//		...
+//	}
+var (
+	// ErrNotFound matches 404 Not Found responses.
+	ErrNotFound = errors.New("not found")
+
+	// ErrDeploymentNotFound matches 404 Not Found responses of the operations that act on the deployment itself, such
+	// as GetDeploymentInfo or SetAllowlist. It also matches ErrNotFound.
+	ErrDeploymentNotFound = errors.New("deployment not found")
+
+	// ErrTaskConflict matches 409 Conflict responses, which refuse an operation because another task is already running
+	// on the deployment. The API definition this client is generated from does not describe these responses, so only
+	// the status code is matched: 409 is the HTTP status of a request that conflicts with the state of its target.
+	ErrTaskConflict = errors.New("another task is already running on the deployment")
+
+	// ErrValidation matches 400 Bad Request and 422 Unprocessable Entity responses; APIError.Fields describes the
+	// invalid fields when the service names them.
+	ErrValidation = errors.New("validation failed")
+
+	// ErrRateLimited matches 429 Too Many Requests responses; APIError.RetryAfter is the delay requested by the
+	// service, if any.
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrUnauthorized matches 401 Unauthorized and 403 Forbidden responses.
+	ErrUnauthorized = errors.New("unauthorized")
+)
+
+// deploymentOperations are the operations whose only path parameter is the deployment ID, so a 404 response means
+// that the deployment does not exist.
+var deploymentOperations = map[string]bool{
+	"getDeploymentInfo":                true,
+	"getDatabaseConfigurationSchema":   true,
+	"updateDatabaseConfiguration":      true,
+	"listRemotes":                      true,
+	"resyncReplica":                    true,
+	"promoteReadOnlyReplica":           true,
+	"listDeploymentTasks":              true,
+	"listDeploymentBackups":            true,
+	"startOndemandBackup":              true,
+	"getPITRData":                      true,
+	"listDeploymentScalingGroups":      true,
+	"killConnections":                  true,
+	"createLogicalReplicationSlot":     true,
+	"getAllowlist":                     true,
+	"setAllowlist":                     true,
+	"addAllowlistEntry":                true,
+	"setDatabaseInplaceVersionUpgrade": true,
+}
+
+// APIError : An error response of the service.
+// It extends the problem reported for the response, so errors.As still finds the *core.HTTPProblem with the full
+// response, and errors.Is matches the class of the error: ErrDeploymentNotFound, ErrNotFound, ErrTaskConflict,
+// ErrValidation, ErrRateLimited or ErrUnauthorized.
+type APIError struct {
+	*core.SDKProblem
+
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The error code of the response, if any, for example "validation_error".
+	Code string
+
+	// The error message of the response.
+	Message string
+
+	// The invalid fields of a validation error, when the service names them.
+	Fields []FieldError
+
+	// The delay requested by the Retry-After header of the response, or zero.
+	RetryAfter time.Duration
+
+	// The class of the error, or nil if the response does not belong to any.
+	Err error
+}
+
+// FieldError : A validation error about a field of the request.
+type FieldError struct {
+	// The name of the field, for example "user.password".
+	Field string
+
+	// The error code, if any.
+	Code string
+
+	// What is wrong with the field.
+	Message string
+}
+
+// Unwrap returns the problem reported for the response.
+func (e *APIError) Unwrap() error {
+	return e.SDKProblem
+}
+
+// Is returns true if target is the class of the error.
+func (e *APIError) Is(target error) bool {
+	if e.Err == nil {
+		return false
+	}
+	return target == e.Err || (e.Err == ErrDeploymentNotFound && target == ErrNotFound)
+}
+
+// String returns the field and its message.
+func (e FieldError) String() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// IsRetryable returns true if the operation that returned err may succeed when tried again later: the service was
+// rate limiting or busy with another task on the deployment, answered 502, 503 or 504, or the request timed out. It
+// returns false when the context of the operation was canceled or reached its deadline.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if apiErr.Err == ErrRateLimited || apiErr.Err == ErrTaskConflict {
+			return true
+		}
+		switch apiErr.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// newAPIError extends the problem returned for a failed request into an *APIError if it holds an error response.
+func newAPIError(err error) error {
+	sdkProblem, ok := err.(*core.SDKProblem)
+	var httpProblem *core.HTTPProblem
+	if !ok || !errors.As(err, &httpProblem) || httpProblem.Response == nil {
+		return err
+	}
+	response := httpProblem.Response
+
+	apiErr := &APIError{
+		SDKProblem: sdkProblem,
+		StatusCode: response.GetStatusCode(),
+	}
+	if result, ok := response.GetResultAsMap(); ok {
+		apiErr.parseErrorBody(result)
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = httpProblem.Summary
+	}
+	if retryAfter := response.GetHeaders().Get("Retry-After"); retryAfter != "" {
+		apiErr.RetryAfter = parseRetryAfter(retryAfter)
+	}
+
+	switch {
+	case apiErr.StatusCode == http.StatusConflict:
+		apiErr.Err = ErrTaskConflict
+	case apiErr.StatusCode == http.StatusNotFound && deploymentOperations[httpProblem.OperationID]:
+		apiErr.Err = ErrDeploymentNotFound
+	case apiErr.StatusCode == http.StatusNotFound:
+		apiErr.Err = ErrNotFound
+	case apiErr.StatusCode == http.StatusBadRequest || apiErr.StatusCode == http.StatusUnprocessableEntity:
+		apiErr.Err = ErrValidation
+	case apiErr.StatusCode == http.StatusTooManyRequests:
+		apiErr.Err = ErrRateLimited
+	case apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden:
+		apiErr.Err = ErrUnauthorized
+	}
+	return apiErr
+}
+
+// parseErrorBody reads the code, message and invalid fields of an error response. The "errors" property is either a
+// list of {"code", "message", "target": {"name"}} objects, a map of field names to messages, or a single message.
+func (e *APIError) parseErrorBody(body map[string]interface{}) {
+	e.Code, _ = body["code"].(string)
+	e.Message, _ = body["message"].(string)
+	if e.Message == "" {
+		e.Message, _ = body["error"].(string)
+	}
+
+	var messages []string
+	switch errs := body["errors"].(type) {
+	case string:
+		messages = append(messages, errs)
+	case []interface{}:
+		for _, item := range errs {
+			itemMap, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			fieldError := FieldError{}
+			fieldError.Code, _ = itemMap["code"].(string)
+			fieldError.Message, _ = itemMap["message"].(string)
+			if target, ok := itemMap["target"].(map[string]interface{}); ok {
+				fieldError.Field, _ = target["name"].(string)
+			}
+			if e.Code == "" {
+				e.Code = fieldError.Code
+			}
+			messages = append(messages, fieldError.Message)
+			if fieldError.Field != "" {
+				e.Fields = append(e.Fields, fieldError)
+			}
+		}
+	case map[string]interface{}:
+		for field, fieldMessages := range errs {
+			switch fieldMessages := fieldMessages.(type) {
+			case string:
+				e.Fields = append(e.Fields, FieldError{Field: field, Message: fieldMessages})
+			case []interface{}:
+				for _, message := range fieldMessages {
+					e.Fields = append(e.Fields, FieldError{Field: field, Message: fmt.Sprint(message)})
+				}
+			}
+		}
+		sort.SliceStable(e.Fields, func(i, j int) bool {
+			return e.Fields[i].Field < e.Fields[j].Field
+		})
+		for _, fieldError := range e.Fields {
+			messages = append(messages, fieldError.String())
+		}
+	}
+	if e.Message == "" {
+		e.Message = strings.Join(messages, "; ")
+	}
+}
+
+// parseRetryAfter parses a Retry-After header holding either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay
+		}
+	}
+	return 0
+}
@@ -0,0 +1,178 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5/fake"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`APIError`, func() {
+	var testServer *httptest.Server
+	var cloudDatabasesService *clouddatabasesv5.CloudDatabasesV5
+	var statusCode int
+	var headers map[string]string
+	var body string
+
+	BeforeEach(func() {
+		headers = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			for name, value := range headers {
+				res.Header().Set(name, value)
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(statusCode)
+			fmt.Fprint(res, body)
+		}))
+		var err error
+		cloudDatabasesService, err = clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		cloudDatabasesService.DisableRetries()
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	getDeploymentInfo := func() error {
+		_, _, err := cloudDatabasesService.GetDeploymentInfo(cloudDatabasesService.NewGetDeploymentInfoOptions("testString"))
+		return err
+	}
+
+	It(`Classify error responses`, func() {
+		classes := map[int]error{
+			401: clouddatabasesv5.ErrUnauthorized,
+			403: clouddatabasesv5.ErrUnauthorized,
+			404: clouddatabasesv5.ErrDeploymentNotFound,
+			400: clouddatabasesv5.ErrValidation,
+			409: clouddatabasesv5.ErrTaskConflict,
+			429: clouddatabasesv5.ErrRateLimited,
+		}
+		body = `{"errors": [{"code": "some_code", "message": "Something went wrong"}]}`
+		for code, class := range classes {
+			statusCode = code
+			err := getDeploymentInfo()
+			Expect(errors.Is(err, class)).To(BeTrue(), fmt.Sprint(code))
+
+			var apiErr *clouddatabasesv5.APIError
+			Expect(errors.As(err, &apiErr)).To(BeTrue())
+			Expect(apiErr.StatusCode).To(Equal(code))
+			Expect(apiErr.Code).To(Equal("some_code"))
+			Expect(apiErr.Message).To(Equal("Something went wrong"))
+
+			var httpProblem *core.HTTPProblem
+			Expect(errors.As(err, &httpProblem)).To(BeTrue())
+			Expect(httpProblem.Response.StatusCode).To(Equal(code))
+		}
+
+		statusCode = 500
+		err := getDeploymentInfo()
+		var apiErr *clouddatabasesv5.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.Err).To(BeNil())
+		Expect(errors.Is(err, clouddatabasesv5.ErrValidation)).To(BeFalse())
+	})
+	It(`Tell a missing deployment from another missing resource`, func() {
+		statusCode = 404
+		body = `{"errors": [{"code": "not_found", "message": "Not found"}]}`
+		err := getDeploymentInfo()
+		Expect(errors.Is(err, clouddatabasesv5.ErrDeploymentNotFound)).To(BeTrue())
+		Expect(errors.Is(err, clouddatabasesv5.ErrNotFound)).To(BeTrue())
+
+		_, _, err = cloudDatabasesService.GetTask(cloudDatabasesService.NewGetTaskOptions("testString"))
+		Expect(errors.Is(err, clouddatabasesv5.ErrNotFound)).To(BeTrue())
+		Expect(errors.Is(err, clouddatabasesv5.ErrDeploymentNotFound)).To(BeFalse())
+	})
+	It(`Parse the invalid fields of a validation error`, func() {
+		statusCode = 422
+		body = `{"errors": {"password": ["is too short", "is too common"], "username": "is taken"}}`
+		err := getDeploymentInfo()
+		Expect(errors.Is(err, clouddatabasesv5.ErrValidation)).To(BeTrue())
+		var apiErr *clouddatabasesv5.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.Fields).To(Equal([]clouddatabasesv5.FieldError{
+			{Field: "password", Message: "is too short"},
+			{Field: "password", Message: "is too common"},
+			{Field: "username", Message: "is taken"},
+		}))
+		Expect(apiErr.Message).To(Equal("password: is too short; password: is too common; username: is taken"))
+	})
+	It(`Read the Retry-After header`, func() {
+		statusCode = 429
+		headers = map[string]string{"Retry-After": "7"}
+		body = `{"message": "Slow down"}`
+		err := getDeploymentInfo()
+		var apiErr *clouddatabasesv5.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.RetryAfter).To(Equal(7 * time.Second))
+		Expect(apiErr.Message).To(Equal("Slow down"))
+	})
+	It(`Tell which errors are retryable`, func() {
+		body = `{"errors": [{"code": "some_code", "message": "Something went wrong"}]}`
+		retryable := map[int]bool{429: true, 502: true, 503: true, 504: true, 409: true, 500: false, 404: false, 422: false, 401: false}
+		for code, expected := range retryable {
+			statusCode = code
+			Expect(clouddatabasesv5.IsRetryable(getDeploymentInfo())).To(Equal(expected), fmt.Sprint(code))
+		}
+		Expect(clouddatabasesv5.IsRetryable(nil)).To(BeFalse())
+		Expect(clouddatabasesv5.IsRetryable(errors.New("other"))).To(BeFalse())
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+		defer cancel()
+		time.Sleep(time.Millisecond)
+		testServer.Close()
+		_, _, err := cloudDatabasesService.GetDeploymentInfoWithContext(ctx, cloudDatabasesService.NewGetDeploymentInfoOptions("testString"))
+		Expect(err).ToNot(BeNil())
+		Expect(clouddatabasesv5.IsRetryable(err)).To(BeFalse())
+	})
+	It(`Classify the errors of the fake server`, func() {
+		server := fake.NewServer(&fake.Options{RejectConcurrentTasks: true, RunningDuration: time.Hour})
+		defer server.Close()
+		deploymentID := "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/abc123:d1a2b3c4-0000-4000-8000-000000000001::"
+		server.AddDeployment(clouddatabasesv5.Deployment{ID: core.StringPtr(deploymentID), Type: core.StringPtr("postgresql")})
+		Expect(cloudDatabasesService.SetServiceURL(server.URL)).To(BeNil())
+
+		addAllowlistEntryOptions := cloudDatabasesService.NewAddAllowlistEntryOptions(deploymentID)
+		_, _, err := cloudDatabasesService.AddAllowlistEntry(addAllowlistEntryOptions)
+		var apiErr *clouddatabasesv5.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.Err).To(Equal(clouddatabasesv5.ErrValidation))
+		Expect(apiErr.Fields).To(HaveLen(1))
+		Expect(apiErr.Fields[0].Field).To(Equal("ip_address"))
+
+		_, _, err = cloudDatabasesService.StartOndemandBackup(cloudDatabasesService.NewStartOndemandBackupOptions(deploymentID))
+		Expect(err).To(BeNil())
+		_, _, err = cloudDatabasesService.StartOndemandBackup(cloudDatabasesService.NewStartOndemandBackupOptions(deploymentID))
+		Expect(errors.Is(err, clouddatabasesv5.ErrTaskConflict)).To(BeTrue())
+		Expect(clouddatabasesv5.IsRetryable(err)).To(BeTrue())
+
+		_, _, err = cloudDatabasesService.GetAllowlist(cloudDatabasesService.NewGetAllowlistOptions("unknown"))
+		Expect(errors.Is(err, clouddatabasesv5.ErrDeploymentNotFound)).To(BeTrue())
+	})
+})
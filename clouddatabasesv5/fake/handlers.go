@@ -0,0 +1,655 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/crn"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// route describes a request: its method and unescaped path segments.
+type route struct {
+	method   string
+	segments []string
+}
+
+// match reports whether the route has the given method and path pattern, where "*" matches any single segment.
+func (r route) match(method string, pattern ...string) bool {
+	if r.method != method || len(r.segments) != len(pattern) {
+		return false
+	}
+	for i, p := range pattern {
+		if p != "*" && p != r.segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) serveHTTP(res http.ResponseWriter, req *http.Request) {
+	var segments []string
+	for _, segment := range strings.Split(strings.Trim(req.URL.EscapedPath(), "/"), "/") {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			writeError(res, http.StatusBadRequest, "invalid_path", err.Error())
+			return
+		}
+		segments = append(segments, unescaped)
+	}
+	r := route{method: req.Method, segments: segments}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settle()
+
+	switch {
+	case r.match(http.MethodGet, "deployables"):
+		s.listDeployables(res)
+	case r.match(http.MethodGet, "deployables", "*", "groups"):
+		writeJSON(res, http.StatusOK, map[string]interface{}{"groups": defaultGroups()})
+	case r.match(http.MethodGet, "regions"):
+		writeJSON(res, http.StatusOK, map[string]interface{}{"regions": s.regions()})
+	case r.match(http.MethodGet, "tasks", "*"):
+		s.getTask(res, segments[1])
+	case r.match(http.MethodGet, "backups", "*"):
+		s.getBackup(res, segments[1])
+	case r.match(http.MethodPost, "capability", "*"):
+		writeJSON(res, http.StatusOK, map[string]interface{}{"capability": s.capability(segments[1], nil)})
+	case len(segments) >= 2 && segments[0] == "deployments":
+		d, ok := s.deployments[segments[1]]
+		if !ok {
+			writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("Deployment %s not found", segments[1]))
+			return
+		}
+		s.serveDeployment(res, req, route{method: req.Method, segments: segments[2:]}, d)
+	default:
+		writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("%s %s is not supported", req.Method, req.URL.Path))
+	}
+}
+
+func (s *Server) serveDeployment(res http.ResponseWriter, req *http.Request, r route, d *deployment) {
+	switch {
+	case r.match(http.MethodGet):
+		writeJSON(res, http.StatusOK, map[string]interface{}{"deployment": d.info})
+
+	case r.match(http.MethodPost, "users", "*"):
+		s.createUser(res, req, d, r.segments[1])
+	case r.match(http.MethodPatch, "users", "*", "*"):
+		s.updateUser(res, req, d, r.segments[1], r.segments[2])
+	case r.match(http.MethodDelete, "users", "*", "*"):
+		s.deleteUser(res, d, r.segments[1], r.segments[2])
+
+	case r.match(http.MethodGet, "configuration", "schema"):
+		s.configurationSchema(res, d)
+	case r.match(http.MethodPatch, "configuration"):
+		s.updateConfiguration(res, req, d)
+
+	case r.match(http.MethodGet, "remotes"):
+		writeJSON(res, http.StatusOK, map[string]interface{}{"remotes": d.remotes})
+	case r.match(http.MethodPost, "remotes", "resync"):
+		s.simpleTask(res, d, clouddatabasesv5.TaskResourceTypeInstanceConst, "Resyncing read-only replica", nil)
+	case r.match(http.MethodPost, "remotes", "promotion"):
+		s.simpleTask(res, d, clouddatabasesv5.TaskResourceTypeInstanceConst, "Promoting read-only replica", func() {
+			d.remotes.Leader = nil
+		})
+
+	case r.match(http.MethodGet, "tasks"):
+		s.listTasks(res, d)
+
+	case r.match(http.MethodGet, "backups"):
+		s.listBackups(res, d)
+	case r.match(http.MethodPost, "backups"):
+		s.startBackup(res, d)
+	case r.match(http.MethodGet, "point_in_time_recovery_data"):
+		writeJSON(res, http.StatusOK, map[string]interface{}{
+			"point_in_time_recovery_data": map[string]string{
+				"earliest_point_in_time_recovery_time": s.options.Clock.Now().UTC().Format("2006-01-02T15:04:05Z"),
+			},
+		})
+
+	case r.match(http.MethodGet, "groups"):
+		writeJSON(res, http.StatusOK, map[string]interface{}{"groups": d.groups})
+	case r.match(http.MethodPatch, "groups", "*"):
+		s.setScalingGroup(res, req, d, r.segments[1])
+	case r.match(http.MethodGet, "groups", "*", "autoscaling"):
+		writeJSON(res, http.StatusOK, map[string]interface{}{"autoscaling": d.autoscalingFor(r.segments[1])})
+	case r.match(http.MethodPatch, "groups", "*", "autoscaling"):
+		s.setAutoscaling(res, req, d, r.segments[1])
+
+	case r.match(http.MethodDelete, "management", "database_connections"):
+		s.simpleTask(res, d, clouddatabasesv5.TaskResourceTypeInstanceConst, "Killing database connections", nil)
+	case r.match(http.MethodPost, "postgresql", "logical_replication_slots"):
+		s.createReplicationSlot(res, req, d)
+	case r.match(http.MethodDelete, "postgresql", "logical_replication_slots", "*"):
+		name := r.segments[2]
+		if _, ok := d.replicationSlots[name]; !ok {
+			writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("Logical replication slot %s not found", name))
+			return
+		}
+		s.simpleTask(res, d, clouddatabasesv5.TaskResourceTypeInstanceConst, "Deleting logical replication slot", func() {
+			delete(d.replicationSlots, name)
+		})
+
+	case r.match(http.MethodGet, "allowlists", "ip_addresses"):
+		res.Header().Set("ETag", d.allowlistETag())
+		writeJSON(res, http.StatusOK, map[string]interface{}{"ip_addresses": d.allowlistOrEmpty()})
+	case r.match(http.MethodPut, "allowlists", "ip_addresses"):
+		s.setAllowlist(res, req, d)
+	case r.match(http.MethodPost, "allowlists", "ip_addresses"):
+		s.addAllowlistEntry(res, req, d)
+	case r.match(http.MethodDelete, "allowlists", "ip_addresses", "*"):
+		s.deleteAllowlistEntry(res, d, r.segments[2])
+
+	case r.match(http.MethodGet, "capability", "*"):
+		writeJSON(res, http.StatusOK, map[string]interface{}{"capability": s.capability(r.segments[1], d)})
+
+	case r.match(http.MethodPatch, "version"):
+		s.upgradeVersion(res, req, d)
+
+	default:
+		writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("%s %s is not supported", req.Method, req.URL.Path))
+	}
+}
+
+// decodeBody decodes the JSON request body into a map of raw messages, writing an error response on failure.
+func decodeBody(res http.ResponseWriter, req *http.Request) (body map[string]json.RawMessage, ok bool) {
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		writeError(res, http.StatusBadRequest, "invalid_body", fmt.Sprintf("Invalid JSON body: %s", err.Error()))
+		return nil, false
+	}
+	return body, true
+}
+
+// decodeField decodes a required field of the request body, writing an error response on failure.
+func decodeField(res http.ResponseWriter, body map[string]json.RawMessage, name string, v interface{}) bool {
+	raw, ok := body[name]
+	if !ok {
+		writeFieldError(res, name, fmt.Sprintf("%s is required", name))
+		return false
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		writeFieldError(res, name, fmt.Sprintf("%s is invalid: %s", name, err.Error()))
+		return false
+	}
+	return true
+}
+
+func (s *Server) simpleTask(res http.ResponseWriter, d *deployment, resourceType string, description string, apply func()) {
+	if t := s.startTask(res, d, resourceType, description, apply); t != nil {
+		writeTask(res, t)
+	}
+}
+
+func (s *Server) listDeployables(res http.ResponseWriter) {
+	versions := make(map[string]map[string]bool)
+	for _, d := range s.deployments {
+		typ, version := core.StringNilMapper(d.info.Type), core.StringNilMapper(d.info.Version)
+		if typ == "" {
+			continue
+		}
+		if versions[typ] == nil {
+			versions[typ] = make(map[string]bool)
+		}
+		if version != "" {
+			versions[typ][version] = true
+		}
+	}
+	deployables := []clouddatabasesv5.Deployables{}
+	for _, typ := range sortedKeys(versions) {
+		deployable := clouddatabasesv5.Deployables{Type: core.StringPtr(typ)}
+		for _, version := range sortedKeys(versions[typ]) {
+			deployable.Versions = append(deployable.Versions, clouddatabasesv5.DeployablesVersionsItem{
+				Version: core.StringPtr(version),
+				Status:  core.StringPtr(clouddatabasesv5.DeployablesVersionsItemStatusStableConst),
+			})
+		}
+		deployables = append(deployables, deployable)
+	}
+	writeJSON(res, http.StatusOK, map[string]interface{}{"deployables": deployables})
+}
+
+func (s *Server) regions() []string {
+	regions := map[string]bool{}
+	for _, d := range s.deployments {
+		if region := regionOf(core.StringNilMapper(d.info.ID)); region != "" {
+			regions[region] = true
+		}
+	}
+	return sortedKeys(regions)
+}
+
+func (s *Server) getTask(res http.ResponseWriter, id string) {
+	for _, t := range s.tasks {
+		if *t.model.ID == id {
+			writeJSON(res, http.StatusOK, map[string]interface{}{"task": s.taskView(t)})
+			return
+		}
+	}
+	writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("Task %s not found", id))
+}
+
+func (s *Server) listTasks(res http.ResponseWriter, d *deployment) {
+	tasks := []clouddatabasesv5.Task{}
+	for i := len(s.tasks) - 1; i >= 0; i-- {
+		if *s.tasks[i].model.DeploymentID == *d.info.ID {
+			tasks = append(tasks, s.taskView(s.tasks[i]))
+		}
+	}
+	writeJSON(res, http.StatusOK, map[string]interface{}{"tasks": tasks})
+}
+
+func (s *Server) getBackup(res http.ResponseWriter, id string) {
+	backup, ok := s.backups[id]
+	if !ok {
+		writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("Backup %s not found", id))
+		return
+	}
+	writeJSON(res, http.StatusOK, map[string]interface{}{"backup": backup})
+}
+
+func (s *Server) listBackups(res http.ResponseWriter, d *deployment) {
+	backups := []clouddatabasesv5.Backup{}
+	for i := len(d.backupIDs) - 1; i >= 0; i-- {
+		backups = append(backups, *s.backups[d.backupIDs[i]])
+	}
+	writeJSON(res, http.StatusOK, map[string]interface{}{"backups": backups})
+}
+
+func (s *Server) startBackup(res http.ResponseWriter, d *deployment) {
+	now := s.options.Clock.Now()
+	backup := &clouddatabasesv5.Backup{
+		ID:             core.StringPtr(s.newID("backup")),
+		DeploymentID:   d.info.ID,
+		Type:           core.StringPtr(clouddatabasesv5.BackupTypeOnDemandConst),
+		Status:         core.StringPtr(clouddatabasesv5.BackupStatusRunningConst),
+		IsDownloadable: core.BoolPtr(false),
+		IsRestorable:   core.BoolPtr(false),
+		CreatedAt:      (*strfmt.DateTime)(&now),
+	}
+	t := s.startTask(res, d, clouddatabasesv5.TaskResourceTypeBackupConst, "Creating an on-demand backup", func() {
+		backup.Status = core.StringPtr(clouddatabasesv5.BackupStatusCompletedConst)
+		backup.IsDownloadable = core.BoolPtr(true)
+		backup.IsRestorable = core.BoolPtr(true)
+	})
+	if t == nil {
+		return
+	}
+	if t.fail {
+		backup.Status = core.StringPtr(clouddatabasesv5.BackupStatusFailedConst)
+	}
+	s.backups[*backup.ID] = backup
+	d.backupIDs = append(d.backupIDs, *backup.ID)
+	writeTask(res, t)
+}
+
+func (s *Server) createUser(res http.ResponseWriter, req *http.Request, d *deployment, userType string) {
+	body, ok := decodeBody(res, req)
+	if !ok {
+		return
+	}
+	var u clouddatabasesv5.User
+	if !decodeField(res, body, "user", &u) {
+		return
+	}
+	if core.StringNilMapper(u.Username) == "" || core.StringNilMapper(u.Password) == "" {
+		writeError(res, http.StatusUnprocessableEntity, "validation_error", "username and password are required")
+		return
+	}
+	username := *u.Username
+	if _, exists := d.users[userType][username]; exists {
+		writeError(res, http.StatusUnprocessableEntity, "validation_error", fmt.Sprintf("User %s already exists", username))
+		return
+	}
+	s.simpleTask(res, d, clouddatabasesv5.TaskResourceTypeUserConst, "Creating user", func() {
+		if d.users[userType] == nil {
+			d.users[userType] = make(map[string]*user)
+		}
+		d.users[userType][username] = &user{
+			password: *u.Password,
+			role:     core.StringNilMapper(u.Role),
+		}
+	})
+}
+
+func (s *Server) updateUser(res http.ResponseWriter, req *http.Request, d *deployment, userType string, username string) {
+	existing, exists := d.users[userType][username]
+	if !exists {
+		writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("User %s not found", username))
+		return
+	}
+	body, ok := decodeBody(res, req)
+	if !ok {
+		return
+	}
+	var u clouddatabasesv5.UserUpdate
+	if !decodeField(res, body, "user", &u) {
+		return
+	}
+	resourceType, description := clouddatabasesv5.TaskResourceTypeUserConst, "Updating user role"
+	if u.Password != nil {
+		resourceType, description = clouddatabasesv5.TaskResourceTypePasswordConst, "Updating user password"
+	}
+	s.simpleTask(res, d, resourceType, description, func() {
+		if u.Password != nil {
+			existing.password = *u.Password
+		}
+		if u.Role != nil {
+			existing.role = *u.Role
+		}
+	})
+}
+
+func (s *Server) deleteUser(res http.ResponseWriter, d *deployment, userType string, username string) {
+	if _, exists := d.users[userType][username]; !exists {
+		writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("User %s not found", username))
+		return
+	}
+	s.simpleTask(res, d, clouddatabasesv5.TaskResourceTypeUserConst, "Deleting user", func() {
+		delete(d.users[userType], username)
+	})
+}
+
+// configurationSchema describes the configuration values applied to the deployment, with their current value.
+func (s *Server) configurationSchema(res http.ResponseWriter, d *deployment) {
+	schema := make(map[string]clouddatabasesv5.ConfigurationSchemaSetting, len(d.configuration))
+	for k, v := range d.configuration {
+		kind := "string"
+		switch v.(type) {
+		case bool:
+			kind = "boolean"
+		case float64, json.Number:
+			kind = "integer"
+		}
+		schema[k] = clouddatabasesv5.ConfigurationSchemaSetting{
+			Kind:                 core.StringPtr(kind),
+			CustomerConfigurable: core.BoolPtr(true),
+			RequiresRestart:      core.BoolPtr(false),
+			Value:                v,
+		}
+	}
+	writeJSON(res, http.StatusOK, map[string]interface{}{"schema": schema})
+}
+
+func (s *Server) updateConfiguration(res http.ResponseWriter, req *http.Request, d *deployment) {
+	body, ok := decodeBody(res, req)
+	if !ok {
+		return
+	}
+	var configuration map[string]interface{}
+	if !decodeField(res, body, "configuration", &configuration) {
+		return
+	}
+	s.simpleTask(res, d, clouddatabasesv5.TaskResourceTypeConfigurationConst, "Applying configuration", func() {
+		for k, v := range configuration {
+			d.configuration[k] = v
+		}
+	})
+}
+
+func (s *Server) setScalingGroup(res http.ResponseWriter, req *http.Request, d *deployment, groupID string) {
+	var group *clouddatabasesv5.Group
+	for i := range d.groups {
+		if core.StringNilMapper(d.groups[i].ID) == groupID {
+			group = &d.groups[i]
+		}
+	}
+	if group == nil {
+		writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("Group %s not found", groupID))
+		return
+	}
+	body, ok := decodeBody(res, req)
+	if !ok {
+		return
+	}
+	var scaling clouddatabasesv5.GroupScaling
+	if !decodeField(res, body, "group", &scaling) {
+		return
+	}
+	s.simpleTask(res, d, clouddatabasesv5.TaskResourceTypeInstanceConst, "Scaling deployment", func() {
+		if scaling.Members != nil && scaling.Members.AllocationCount != nil {
+			if group.Members == nil {
+				group.Members = new(clouddatabasesv5.GroupMembers)
+			}
+			group.Members.AllocationCount = scaling.Members.AllocationCount
+			group.Count = scaling.Members.AllocationCount
+		}
+		if scaling.Memory != nil && scaling.Memory.AllocationMb != nil {
+			if group.Memory == nil {
+				group.Memory = new(clouddatabasesv5.GroupMemory)
+			}
+			group.Memory.AllocationMb = scaling.Memory.AllocationMb
+		}
+		if scaling.CPU != nil && scaling.CPU.AllocationCount != nil {
+			if group.CPU == nil {
+				group.CPU = new(clouddatabasesv5.GroupCPU)
+			}
+			group.CPU.AllocationCount = scaling.CPU.AllocationCount
+		}
+		if scaling.Disk != nil && scaling.Disk.AllocationMb != nil {
+			if group.Disk == nil {
+				group.Disk = new(clouddatabasesv5.GroupDisk)
+			}
+			group.Disk.AllocationMb = scaling.Disk.AllocationMb
+		}
+		if scaling.HostFlavor != nil && scaling.HostFlavor.ID != nil {
+			group.HostFlavor = &clouddatabasesv5.GroupHostFlavor{ID: scaling.HostFlavor.ID}
+		}
+	})
+}
+
+func (d *deployment) autoscalingFor(groupID string) map[string]interface{} {
+	if autoscaling, ok := d.autoscaling[groupID]; ok {
+		return autoscaling
+	}
+	return map[string]interface{}{}
+}
+
+func (s *Server) setAutoscaling(res http.ResponseWriter, req *http.Request, d *deployment, groupID string) {
+	body, ok := decodeBody(res, req)
+	if !ok {
+		return
+	}
+	var autoscaling map[string]interface{}
+	if !decodeField(res, body, "autoscaling", &autoscaling) {
+		return
+	}
+	s.simpleTask(res, d, clouddatabasesv5.TaskResourceTypeInstanceConst, "Updating autoscaling", func() {
+		current := d.autoscalingFor(groupID)
+		for k, v := range autoscaling {
+			current[k] = v
+		}
+		d.autoscaling[groupID] = current
+	})
+}
+
+func (s *Server) createReplicationSlot(res http.ResponseWriter, req *http.Request, d *deployment) {
+	body, ok := decodeBody(res, req)
+	if !ok {
+		return
+	}
+	var slot clouddatabasesv5.LogicalReplicationSlot
+	if !decodeField(res, body, "logical_replication_slot", &slot) {
+		return
+	}
+	if core.StringNilMapper(slot.Name) == "" {
+		writeFieldError(res, "logical_replication_slot.name", "logical_replication_slot.name is required")
+		return
+	}
+	s.simpleTask(res, d, clouddatabasesv5.TaskResourceTypeInstanceConst, "Creating logical replication slot", func() {
+		d.replicationSlots[*slot.Name] = slot
+	})
+}
+
+func (d *deployment) allowlistETag() string {
+	return fmt.Sprintf(`"%d"`, d.allowlistVersion)
+}
+
+func (d *deployment) allowlistOrEmpty() []clouddatabasesv5.AllowlistEntry {
+	if d.allowlist == nil {
+		return []clouddatabasesv5.AllowlistEntry{}
+	}
+	return d.allowlist
+}
+
+// setAllowlistEntries replaces the allowlist; the ETag changes whenever the allowlist does.
+func (d *deployment) setAllowlistEntries(entries []clouddatabasesv5.AllowlistEntry) {
+	d.allowlist = entries
+	d.allowlistVersion++
+}
+
+func (s *Server) setAllowlist(res http.ResponseWriter, req *http.Request, d *deployment) {
+	if ifMatch := req.Header.Get("If-Match"); ifMatch != "" && ifMatch != d.allowlistETag() {
+		writeError(res, http.StatusPreconditionFailed, "precondition_failed", "The allowlist was modified since it was retrieved")
+		return
+	}
+	body, ok := decodeBody(res, req)
+	if !ok {
+		return
+	}
+	var entries []clouddatabasesv5.AllowlistEntry
+	if !decodeField(res, body, "ip_addresses", &entries) {
+		return
+	}
+	s.simpleTask(res, d, clouddatabasesv5.TaskResourceTypeIPConst, "Setting allowlist", func() {
+		d.setAllowlistEntries(entries)
+	})
+}
+
+func (s *Server) addAllowlistEntry(res http.ResponseWriter, req *http.Request, d *deployment) {
+	body, ok := decodeBody(res, req)
+	if !ok {
+		return
+	}
+	var entry clouddatabasesv5.AllowlistEntry
+	if !decodeField(res, body, "ip_address", &entry) {
+		return
+	}
+	if core.StringNilMapper(entry.Address) == "" {
+		writeFieldError(res, "ip_address.address", "ip_address.address is required")
+		return
+	}
+	for _, existing := range d.allowlist {
+		if *existing.Address == *entry.Address {
+			writeError(res, http.StatusUnprocessableEntity, "validation_error", fmt.Sprintf("%s is already in the allowlist", *entry.Address))
+			return
+		}
+	}
+	s.simpleTask(res, d, clouddatabasesv5.TaskResourceTypeIPConst, "Adding allowlist entry", func() {
+		d.setAllowlistEntries(append(append([]clouddatabasesv5.AllowlistEntry(nil), d.allowlist...), entry))
+	})
+}
+
+func (s *Server) deleteAllowlistEntry(res http.ResponseWriter, d *deployment, address string) {
+	found := false
+	for _, existing := range d.allowlist {
+		found = found || *existing.Address == address
+	}
+	if !found {
+		writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("%s is not in the allowlist", address))
+		return
+	}
+	s.simpleTask(res, d, clouddatabasesv5.TaskResourceTypeIPConst, "Deleting allowlist entry", func() {
+		var entries []clouddatabasesv5.AllowlistEntry
+		for _, existing := range d.allowlist {
+			if *existing.Address != address {
+				entries = append(entries, existing)
+			}
+		}
+		d.setAllowlistEntries(entries)
+	})
+}
+
+func (s *Server) upgradeVersion(res http.ResponseWriter, req *http.Request, d *deployment) {
+	body, ok := decodeBody(res, req)
+	if !ok {
+		return
+	}
+	var version string
+	if !decodeField(res, body, "version", &version) {
+		return
+	}
+	s.simpleTask(res, d, clouddatabasesv5.TaskResourceTypeUpgradeConst, "Upgrading database version", func() {
+		d.info.Version = core.StringPtr(version)
+	})
+}
+
+// capability returns the capability set with SetCapability, or a permissive default derived from the deployment.
+func (s *Server) capability(capabilityID string, d *deployment) *clouddatabasesv5.Capability {
+	if capability, ok := s.capabilities[capabilityID]; ok {
+		return capability
+	}
+	capability := new(clouddatabasesv5.Capability)
+	switch capabilityID {
+	case clouddatabasesv5.GetDeploymentCapabilityOptionsCapabilityIDAutoscalingConst:
+		capability.Autoscaling = &clouddatabasesv5.AutoscalingCapability{AutoscalingSupported: core.BoolPtr(true)}
+	case clouddatabasesv5.GetDeploymentCapabilityOptionsCapabilityIDEncryptionConst:
+		capability.Encryption = &clouddatabasesv5.EncryptionCapability{DiskEncryptionSupported: core.BoolPtr(true)}
+	case clouddatabasesv5.GetDeploymentCapabilityOptionsCapabilityIDEndpointsConst:
+		capability.Endpoints = &clouddatabasesv5.EndpointsCapability{
+			PublicEndpointsSupported:   core.BoolPtr(true),
+			PrivateEndpointsSupported:  core.BoolPtr(true),
+			MultipleEndpointsSupported: core.BoolPtr(true),
+		}
+	case clouddatabasesv5.GetDeploymentCapabilityOptionsCapabilityIDGroupsConst:
+		capability.Groups = defaultGroups()
+	case clouddatabasesv5.GetDeploymentCapabilityOptionsCapabilityIDLocationsConst:
+		capability.Locations = &clouddatabasesv5.LocationsCapability{Locations: s.regions()}
+	case clouddatabasesv5.GetDeploymentCapabilityOptionsCapabilityIDPointInTimeRecoveryConst:
+		capability.PointInTimeRecovery = &clouddatabasesv5.PointInTimeRecoveryCapability{PointInTimeRecoverySupported: core.BoolPtr(true)}
+	case clouddatabasesv5.GetDeploymentCapabilityOptionsCapabilityIDRemotesConst:
+		capability.Remotes = &clouddatabasesv5.RemotesCapability{ReadOnlyReplicasSupported: core.BoolPtr(true)}
+	case clouddatabasesv5.GetDeploymentCapabilityOptionsCapabilityIDVersionsConst:
+		if d != nil {
+			capability.Versions = []clouddatabasesv5.VersionsCapabilityItem{
+				{
+					Type:        d.info.Type,
+					Version:     d.info.Version,
+					Status:      core.StringPtr(clouddatabasesv5.VersionsCapabilityItemStatusStableConst),
+					IsPreferred: core.BoolPtr(true),
+				},
+			}
+		}
+	}
+	return capability
+}
+
+// regionOf returns the region field of a CRN, or "" if the ID is not a CRN.
+func regionOf(id string) string {
+	deploymentCRN, err := crn.Parse(id)
+	if err != nil {
+		return ""
+	}
+	return deploymentCRN.Region
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,409 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fake provides an in-process, in-memory implementation of the Cloud Databases v5 REST API, so code that
+// uses the clouddatabasesv5 package can be tested without IBM Cloud. Point CloudDatabasesV5Options.URL at
+// Server.URL and use a core.NoAuthAuthenticator.
+//
+// Operations that return a task do not change the deployment right away: the task moves from "queued" to
+// "running" to "completed" as the server's clock advances, and the change is applied when the task completes.
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// Options : The fake Server options.
+type Options struct {
+	// The clock that drives task progress. Defaults to the system clock.
+	Clock Clock
+
+	// How long a new task stays queued before it starts running.
+	QueuedDuration time.Duration
+
+	// How long a task runs before it completes. With both durations at zero, a task is completed by the time it
+	// is first retrieved.
+	RunningDuration time.Duration
+
+	// Reject task-producing operations with a 409 error while another task on the same deployment is still queued
+	// or running.
+	RejectConcurrentTasks bool
+}
+
+// Server : An in-memory Cloud Databases API served over HTTP.
+type Server struct {
+	*httptest.Server
+
+	options Options
+
+	mu           sync.Mutex
+	deployments  map[string]*deployment
+	tasks        []*task
+	backups      map[string]*clouddatabasesv5.Backup
+	capabilities map[string]*clouddatabasesv5.Capability
+	nextID       int
+}
+
+type deployment struct {
+	info             clouddatabasesv5.Deployment
+	users            map[string]map[string]*user
+	allowlist        []clouddatabasesv5.AllowlistEntry
+	allowlistVersion int
+	configuration    map[string]interface{}
+	groups           []clouddatabasesv5.Group
+	autoscaling      map[string]map[string]interface{}
+	remotes          clouddatabasesv5.Remotes
+	replicationSlots map[string]clouddatabasesv5.LogicalReplicationSlot
+	backupIDs        []string
+	failNextTask     bool
+}
+
+type user struct {
+	password string
+	role     string
+}
+
+type task struct {
+	model     clouddatabasesv5.Task
+	createdAt time.Time
+	fail      bool
+	done      bool
+	apply     func()
+}
+
+// NewServer : Start a fake Cloud Databases API server. Close it when done.
+func NewServer(options *Options) *Server {
+	s := &Server{
+		deployments:  make(map[string]*deployment),
+		backups:      make(map[string]*clouddatabasesv5.Backup),
+		capabilities: make(map[string]*clouddatabasesv5.Capability),
+	}
+	if options != nil {
+		s.options = *options
+	}
+	if s.options.Clock == nil {
+		s.options.Clock = realClock{}
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// AddDeployment : Register a deployment with the server. The deployment ID is required; a "member" scaling group
+// is created for it unless SetScalingGroups is used.
+func (s *Server) AddDeployment(info clouddatabasesv5.Deployment) {
+	if info.ID == nil {
+		panic("fake: deployment ID is required")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deployments[*info.ID] = &deployment{
+		info:             info,
+		users:            make(map[string]map[string]*user),
+		configuration:    make(map[string]interface{}),
+		groups:           defaultGroups(),
+		autoscaling:      make(map[string]map[string]interface{}),
+		replicationSlots: make(map[string]clouddatabasesv5.LogicalReplicationSlot),
+	}
+}
+
+// SetScalingGroups : Replace the scaling groups of a deployment.
+func (s *Server) SetScalingGroups(id string, groups []clouddatabasesv5.Group) {
+	s.withDeployment(id, func(d *deployment) {
+		d.groups = groups
+	})
+}
+
+// SetRemotes : Set the leader and read-only replicas of a deployment.
+func (s *Server) SetRemotes(id string, remotes clouddatabasesv5.Remotes) {
+	s.withDeployment(id, func(d *deployment) {
+		d.remotes = remotes
+	})
+}
+
+// SetAllowlist : Replace the allowlist of a deployment without going through a task.
+func (s *Server) SetAllowlist(id string, entries []clouddatabasesv5.AllowlistEntry) {
+	s.withDeployment(id, func(d *deployment) {
+		d.allowlist = append([]clouddatabasesv5.AllowlistEntry(nil), entries...)
+		d.allowlistVersion++
+	})
+}
+
+// SetCapability : Set the capability returned for the specified capability ID, for every deployment.
+func (s *Server) SetCapability(capabilityID string, capability clouddatabasesv5.Capability) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.capabilities[capabilityID] = &capability
+}
+
+// FailNextTask : Make the next task created for the deployment end with status "failed", without applying its change.
+func (s *Server) FailNextTask(id string) {
+	s.withDeployment(id, func(d *deployment) {
+		d.failNextTask = true
+	})
+}
+
+// Allowlist : Return the current allowlist of a deployment.
+func (s *Server) Allowlist(id string) (entries []clouddatabasesv5.AllowlistEntry) {
+	s.withDeployment(id, func(d *deployment) {
+		entries = append(entries, d.allowlist...)
+	})
+	return
+}
+
+// Users : Return the sorted names of the users of the specified type on a deployment.
+func (s *Server) Users(id string, userType string) (usernames []string) {
+	s.withDeployment(id, func(d *deployment) {
+		for username := range d.users[userType] {
+			usernames = append(usernames, username)
+		}
+	})
+	sort.Strings(usernames)
+	return
+}
+
+// UserPassword : Return the password of a user, if the user exists.
+func (s *Server) UserPassword(id string, userType string, username string) (password string, ok bool) {
+	s.withDeployment(id, func(d *deployment) {
+		var u *user
+		if u, ok = d.users[userType][username]; ok {
+			password = u.password
+		}
+	})
+	return
+}
+
+// UserRole : Return the Redis ACL role of a user, if the user exists.
+func (s *Server) UserRole(id string, userType string, username string) (role string, ok bool) {
+	s.withDeployment(id, func(d *deployment) {
+		var u *user
+		if u, ok = d.users[userType][username]; ok {
+			role = u.role
+		}
+	})
+	return
+}
+
+// Configuration : Return the configuration values that were applied to a deployment.
+func (s *Server) Configuration(id string) (configuration map[string]interface{}) {
+	configuration = make(map[string]interface{})
+	s.withDeployment(id, func(d *deployment) {
+		for k, v := range d.configuration {
+			configuration[k] = v
+		}
+	})
+	return
+}
+
+// ScalingGroups : Return the current scaling groups of a deployment.
+func (s *Server) ScalingGroups(id string) (groups []clouddatabasesv5.Group) {
+	s.withDeployment(id, func(d *deployment) {
+		groups = append(groups, d.groups...)
+	})
+	return
+}
+
+// Tasks : Return the tasks of a deployment, oldest first, as they would currently be reported.
+func (s *Server) Tasks(id string) (tasks []clouddatabasesv5.Task) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settle()
+	for _, t := range s.tasks {
+		if *t.model.DeploymentID == id {
+			tasks = append(tasks, s.taskView(t))
+		}
+	}
+	return
+}
+
+func (s *Server) withDeployment(id string, f func(d *deployment)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settle()
+	d, ok := s.deployments[id]
+	if !ok {
+		panic(fmt.Sprintf("fake: unknown deployment %q", id))
+	}
+	f(d)
+}
+
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s-%d", prefix, s.nextID)
+}
+
+// settle completes every task whose running time has elapsed, applying their changes in creation order.
+func (s *Server) settle() {
+	now := s.options.Clock.Now()
+	for _, t := range s.tasks {
+		if t.done || now.Before(t.createdAt.Add(s.options.QueuedDuration+s.options.RunningDuration)) {
+			continue
+		}
+		t.done = true
+		if !t.fail && t.apply != nil {
+			t.apply()
+		}
+	}
+}
+
+// taskView returns the task as it is currently reported: status and progress depend on the time elapsed.
+func (s *Server) taskView(t *task) clouddatabasesv5.Task {
+	view := t.model
+	elapsed := s.options.Clock.Now().Sub(t.createdAt)
+	var status string
+	var progress int64
+	switch {
+	case t.done && t.fail:
+		status = clouddatabasesv5.TaskStatusFailedConst
+	case t.done:
+		status, progress = clouddatabasesv5.TaskStatusCompletedConst, 100
+	case elapsed < s.options.QueuedDuration:
+		status = clouddatabasesv5.TaskStatusQueuedConst
+	default:
+		status = clouddatabasesv5.TaskStatusRunningConst
+		if s.options.RunningDuration > 0 {
+			progress = int64(100 * (elapsed - s.options.QueuedDuration) / s.options.RunningDuration)
+		}
+	}
+	view.Status = core.StringPtr(status)
+	view.ProgressPercent = core.Int64Ptr(progress)
+	return view
+}
+
+// startTask records a new task for the deployment; apply is run when the task completes successfully.
+// It returns nil after writing an error response if the deployment already has a task in progress and
+// RejectConcurrentTasks is set.
+func (s *Server) startTask(res http.ResponseWriter, d *deployment, resourceType string, description string, apply func()) *task {
+	if s.options.RejectConcurrentTasks {
+		for _, t := range s.tasks {
+			if !t.done && *t.model.DeploymentID == *d.info.ID {
+				writeError(res, http.StatusConflict, "conflict",
+					fmt.Sprintf("A task is already running on deployment %s: %s", *d.info.ID, *t.model.Description))
+				return nil
+			}
+		}
+	}
+
+	now := s.options.Clock.Now()
+	t := &task{
+		model: clouddatabasesv5.Task{
+			ID:           core.StringPtr(s.newID("task")),
+			ResourceType: core.StringPtr(resourceType),
+			Description:  core.StringPtr(description),
+			DeploymentID: d.info.ID,
+			CreatedAt:    (*strfmt.DateTime)(&now),
+		},
+		createdAt: now,
+		fail:      d.failNextTask,
+		apply:     apply,
+	}
+	d.failNextTask = false
+	s.tasks = append(s.tasks, t)
+	return t
+}
+
+// writeTask responds with the newly created task, which is always reported as queued.
+func writeTask(res http.ResponseWriter, t *task) {
+	view := t.model
+	view.Status = core.StringPtr(clouddatabasesv5.TaskStatusQueuedConst)
+	view.ProgressPercent = core.Int64Ptr(0)
+	writeJSON(res, http.StatusAccepted, map[string]interface{}{"task": view})
+}
+
+func writeJSON(res http.ResponseWriter, statusCode int, body interface{}) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(statusCode)
+	_ = json.NewEncoder(res).Encode(body)
+}
+
+func writeError(res http.ResponseWriter, statusCode int, code string, message string) {
+	writeJSON(res, statusCode, map[string]interface{}{
+		"errors": []map[string]string{
+			{"code": code, "message": message},
+		},
+	})
+}
+
+// writeFieldError writes a 422 validation error about a field of the request body.
+func writeFieldError(res http.ResponseWriter, field string, message string) {
+	writeJSON(res, http.StatusUnprocessableEntity, map[string]interface{}{
+		"errors": []map[string]interface{}{
+			{
+				"code":    "validation_error",
+				"message": message,
+				"target":  map[string]string{"type": "field", "name": field},
+			},
+		},
+	})
+}
+
+func defaultGroups() []clouddatabasesv5.Group {
+	return []clouddatabasesv5.Group{
+		{
+			ID:    core.StringPtr(clouddatabasesv5.GroupIDMemberConst),
+			Count: core.Int64Ptr(2),
+			Members: &clouddatabasesv5.GroupMembers{
+				Units:           core.StringPtr("count"),
+				AllocationCount: core.Int64Ptr(2),
+				MinimumCount:    core.Int64Ptr(2),
+				MaximumCount:    core.Int64Ptr(20),
+				StepSizeCount:   core.Int64Ptr(1),
+				IsAdjustable:    core.BoolPtr(true),
+				IsOptional:      core.BoolPtr(false),
+				CanScaleDown:    core.BoolPtr(false),
+			},
+			Memory: &clouddatabasesv5.GroupMemory{
+				Units:        core.StringPtr("mb"),
+				AllocationMb: core.Int64Ptr(8192),
+				MinimumMb:    core.Int64Ptr(2048),
+				MaximumMb:    core.Int64Ptr(229376),
+				StepSizeMb:   core.Int64Ptr(256),
+				IsAdjustable: core.BoolPtr(true),
+				IsOptional:   core.BoolPtr(false),
+				CanScaleDown: core.BoolPtr(true),
+			},
+			CPU: &clouddatabasesv5.GroupCPU{
+				Units:           core.StringPtr("count"),
+				AllocationCount: core.Int64Ptr(0),
+				MinimumCount:    core.Int64Ptr(0),
+				MaximumCount:    core.Int64Ptr(56),
+				StepSizeCount:   core.Int64Ptr(2),
+				IsAdjustable:    core.BoolPtr(true),
+				IsOptional:      core.BoolPtr(true),
+				CanScaleDown:    core.BoolPtr(true),
+			},
+			Disk: &clouddatabasesv5.GroupDisk{
+				Units:        core.StringPtr("mb"),
+				AllocationMb: core.Int64Ptr(20480),
+				MinimumMb:    core.Int64Ptr(20480),
+				MaximumMb:    core.Int64Ptr(4194304),
+				StepSizeMb:   core.Int64Ptr(2048),
+				IsAdjustable: core.BoolPtr(true),
+				IsOptional:   core.BoolPtr(false),
+				CanScaleDown: core.BoolPtr(false),
+			},
+		},
+	}
+}
@@ -0,0 +1,261 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDeploymentID = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/abc123:d1a2b3c4-0000-4000-8000-000000000001::"
+
+func newTestClient(t *testing.T, options *Options) (*Server, *clouddatabasesv5.CloudDatabasesV5) {
+	return NewTestService(t, options, clouddatabasesv5.Deployment{
+		ID:      core.StringPtr(testDeploymentID),
+		Name:    core.StringPtr("example"),
+		Type:    core.StringPtr("postgresql"),
+		Version: core.StringPtr("16"),
+	})
+}
+
+func fastWait() *clouddatabasesv5.WaitForTaskOptions {
+	return new(clouddatabasesv5.WaitForTaskOptions).SetPollInterval(time.Millisecond)
+}
+
+func TestGetDeploymentInfo(t *testing.T) {
+	_, service := newTestClient(t, nil)
+
+	result, _, err := service.GetDeploymentInfo(service.NewGetDeploymentInfoOptions(testDeploymentID))
+	require.Nil(t, err)
+	assert.Equal(t, "example", *result.Deployment.Name)
+
+	_, response, err := service.GetDeploymentInfo(service.NewGetDeploymentInfoOptions("unknown"))
+	assert.NotNil(t, err)
+	assert.Equal(t, 404, response.StatusCode)
+
+	regions, _, err := service.ListRegions(service.NewListRegionsOptions())
+	require.Nil(t, err)
+	assert.Equal(t, []string{"us-south"}, regions.Regions)
+}
+
+func TestTaskLifecycle(t *testing.T) {
+	clock := NewManualClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	server, service := newTestClient(t, &Options{
+		Clock:           clock,
+		QueuedDuration:  10 * time.Second,
+		RunningDuration: 100 * time.Second,
+	})
+
+	options := service.NewSetAllowlistOptions(testDeploymentID)
+	options.IPAddresses = []clouddatabasesv5.AllowlistEntry{{Address: core.StringPtr("10.0.0.0/8")}}
+	result, _, err := service.SetAllowlist(options)
+	require.Nil(t, err)
+	taskID := *result.Task.ID
+
+	getTask := func() *clouddatabasesv5.Task {
+		response, _, err := service.GetTask(service.NewGetTaskOptions(taskID))
+		require.Nil(t, err)
+		return response.Task
+	}
+
+	task := getTask()
+	assert.Equal(t, clouddatabasesv5.TaskStatusQueuedConst, *task.Status)
+	assert.Empty(t, server.Allowlist(testDeploymentID))
+
+	clock.Advance(60 * time.Second)
+	task = getTask()
+	assert.Equal(t, clouddatabasesv5.TaskStatusRunningConst, *task.Status)
+	assert.Equal(t, int64(50), *task.ProgressPercent)
+	assert.Empty(t, server.Allowlist(testDeploymentID))
+
+	clock.Advance(50 * time.Second)
+	task = getTask()
+	assert.Equal(t, clouddatabasesv5.TaskStatusCompletedConst, *task.Status)
+	assert.Equal(t, int64(100), *task.ProgressPercent)
+	assert.Equal(t, "10.0.0.0/8", *server.Allowlist(testDeploymentID)[0].Address)
+
+	tasks, _, err := service.ListDeploymentTasks(service.NewListDeploymentTasksOptions(testDeploymentID))
+	require.Nil(t, err)
+	assert.Len(t, tasks.Tasks, 1)
+}
+
+func TestRejectConcurrentTasks(t *testing.T) {
+	clock := NewManualClock(time.Now())
+	_, service := newTestClient(t, &Options{
+		Clock:                 clock,
+		RunningDuration:       time.Minute,
+		RejectConcurrentTasks: true,
+	})
+
+	_, _, err := service.StartOndemandBackup(service.NewStartOndemandBackupOptions(testDeploymentID))
+	require.Nil(t, err)
+
+	_, response, err := service.KillConnections(service.NewKillConnectionsOptions(testDeploymentID))
+	assert.NotNil(t, err)
+	assert.Equal(t, 409, response.StatusCode)
+
+	clock.Advance(time.Minute)
+	_, _, err = service.KillConnections(service.NewKillConnectionsOptions(testDeploymentID))
+	assert.Nil(t, err)
+}
+
+func TestUsers(t *testing.T) {
+	server, service := newTestClient(t, nil)
+	ctx := context.Background()
+
+	user, err := service.NewUserDatabaseUser("app", "a-long-password-123")
+	require.Nil(t, err)
+	createOptions := service.NewCreateDatabaseUserOptions(testDeploymentID, "database").SetUser(user)
+	_, task, _, err := service.CreateDatabaseUserAndWait(ctx, createOptions, fastWait())
+	require.Nil(t, err)
+	assert.Equal(t, clouddatabasesv5.TaskStatusCompletedConst, *task.Status)
+	assert.Equal(t, []string{"app"}, server.Users(testDeploymentID, "database"))
+
+	_, _, err = service.CreateDatabaseUser(createOptions)
+	assert.NotNil(t, err)
+
+	update, err := service.NewUserUpdatePasswordSetting("another-password-456")
+	require.Nil(t, err)
+	updateOptions := service.NewUpdateUserOptions(testDeploymentID, "database", "app").SetUser(update)
+	_, _, _, err = service.UpdateUserAndWait(ctx, updateOptions, fastWait())
+	require.Nil(t, err)
+	password, _ := server.UserPassword(testDeploymentID, "database", "app")
+	assert.Equal(t, "another-password-456", password)
+
+	deleteOptions := service.NewDeleteDatabaseUserOptions(testDeploymentID, "database", "app")
+	_, _, _, err = service.DeleteDatabaseUserAndWait(ctx, deleteOptions, fastWait())
+	require.Nil(t, err)
+	assert.Empty(t, server.Users(testDeploymentID, "database"))
+}
+
+func TestAllowlist(t *testing.T) {
+	server, service := newTestClient(t, nil)
+	ctx := context.Background()
+
+	entry := &clouddatabasesv5.AllowlistEntry{Address: core.StringPtr("192.168.0.0/16"), Description: core.StringPtr("office")}
+	addOptions := service.NewAddAllowlistEntryOptions(testDeploymentID).SetIPAddress(entry)
+	_, _, _, err := service.AddAllowlistEntryAndWait(ctx, addOptions, fastWait())
+	require.Nil(t, err)
+
+	allowlist, response, err := service.GetAllowlist(service.NewGetAllowlistOptions(testDeploymentID))
+	require.Nil(t, err)
+	assert.Len(t, allowlist.IPAddresses, 1)
+	etag := response.Headers.Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	server.SetAllowlist(testDeploymentID, nil)
+	setOptions := service.NewSetAllowlistOptions(testDeploymentID).SetIfMatch(etag)
+	_, response, err = service.SetAllowlist(setOptions)
+	assert.NotNil(t, err)
+	assert.Equal(t, 412, response.StatusCode)
+
+	_, response, err = service.DeleteAllowlistEntry(service.NewDeleteAllowlistEntryOptions(testDeploymentID, "192.168.0.0/16"))
+	assert.NotNil(t, err)
+	assert.Equal(t, 404, response.StatusCode)
+}
+
+func TestFailNextTask(t *testing.T) {
+	server, service := newTestClient(t, nil)
+	server.FailNextTask(testDeploymentID)
+
+	entry := &clouddatabasesv5.AllowlistEntry{Address: core.StringPtr("10.0.0.1")}
+	addOptions := service.NewAddAllowlistEntryOptions(testDeploymentID).SetIPAddress(entry)
+	_, _, _, err := service.AddAllowlistEntryAndWait(context.Background(), addOptions, fastWait())
+	assert.True(t, errors.Is(err, clouddatabasesv5.ErrTaskFailed))
+	assert.Empty(t, server.Allowlist(testDeploymentID))
+}
+
+func TestBackups(t *testing.T) {
+	_, service := newTestClient(t, nil)
+
+	_, _, _, err := service.StartOndemandBackupAndWait(context.Background(), service.NewStartOndemandBackupOptions(testDeploymentID), fastWait())
+	require.Nil(t, err)
+
+	backups, _, err := service.ListDeploymentBackups(service.NewListDeploymentBackupsOptions(testDeploymentID))
+	require.Nil(t, err)
+	require.Len(t, backups.Backups, 1)
+
+	backup, _, err := service.GetBackupInfo(service.NewGetBackupInfoOptions(*backups.Backups[0].ID))
+	require.Nil(t, err)
+	assert.Equal(t, clouddatabasesv5.BackupStatusCompletedConst, *backup.Backup.Status)
+	assert.True(t, *backup.Backup.IsRestorable)
+}
+
+func TestScalingAndConfiguration(t *testing.T) {
+	server, service := newTestClient(t, nil)
+	ctx := context.Background()
+
+	scalingOptions := service.NewSetDeploymentScalingGroupOptions(testDeploymentID, "member").
+		SetGroup(&clouddatabasesv5.GroupScaling{Memory: &clouddatabasesv5.GroupScalingMemory{AllocationMb: core.Int64Ptr(16384)}})
+	_, _, _, err := service.SetDeploymentScalingGroupAndWait(ctx, scalingOptions, fastWait())
+	require.Nil(t, err)
+
+	groups, _, err := service.ListDeploymentScalingGroups(service.NewListDeploymentScalingGroupsOptions(testDeploymentID))
+	require.Nil(t, err)
+	assert.Equal(t, int64(16384), *groups.Groups[0].Memory.AllocationMb)
+
+	autoscalingOptions := service.NewSetAutoscalingConditionsOptions(testDeploymentID, "member", &clouddatabasesv5.AutoscalingSetGroupAutoscaling{
+		Memory: &clouddatabasesv5.AutoscalingMemoryGroupMemory{
+			Rate: &clouddatabasesv5.AutoscalingMemoryGroupMemoryRate{IncreasePercent: core.Float64Ptr(10)},
+		},
+	})
+	_, _, _, err = service.SetAutoscalingConditionsAndWait(ctx, autoscalingOptions, fastWait())
+	require.Nil(t, err)
+	autoscaling, _, err := service.GetAutoscalingConditions(service.NewGetAutoscalingConditionsOptions(testDeploymentID, "member"))
+	require.Nil(t, err)
+	assert.Equal(t, float64(10), *autoscaling.Autoscaling.Memory.Rate.IncreasePercent)
+
+	configurationOptions := service.NewUpdateDatabaseConfigurationOptions(testDeploymentID).
+		SetConfiguration(&clouddatabasesv5.ConfigurationPgConfiguration{MaxConnections: core.Int64Ptr(200)})
+	_, _, _, err = service.UpdateDatabaseConfigurationAndWait(ctx, configurationOptions, fastWait())
+	require.Nil(t, err)
+	assert.Equal(t, float64(200), server.Configuration(testDeploymentID)["max_connections"])
+
+	schema, _, err := service.GetDatabaseConfigurationSchema(service.NewGetDatabaseConfigurationSchemaOptions(testDeploymentID))
+	require.Nil(t, err)
+	assert.Equal(t, "integer", *schema.Schema["max_connections"].Kind)
+	assert.Equal(t, float64(200), schema.Schema["max_connections"].Value)
+}
+
+func TestRemotesAndCapabilities(t *testing.T) {
+	server, service := newTestClient(t, nil)
+	server.SetRemotes(testDeploymentID, clouddatabasesv5.Remotes{Leader: core.StringPtr("leader-id")})
+
+	_, _, _, err := service.PromoteReadOnlyReplicaAndWait(context.Background(), service.NewPromoteReadOnlyReplicaOptions(testDeploymentID), fastWait())
+	require.Nil(t, err)
+	remotes, _, err := service.ListRemotes(service.NewListRemotesOptions(testDeploymentID))
+	require.Nil(t, err)
+	assert.Nil(t, remotes.Remotes.Leader)
+
+	capability, _, err := service.GetDeploymentCapability(service.NewGetDeploymentCapabilityOptions(testDeploymentID, "versions"))
+	require.Nil(t, err)
+	assert.Equal(t, "16", *capability.Capability.Versions[0].Version)
+
+	server.SetCapability("encryption", clouddatabasesv5.Capability{
+		Encryption: &clouddatabasesv5.EncryptionCapability{DiskEncryptionSupported: core.BoolPtr(false)},
+	})
+	created, _, err := service.CreateCapability(service.NewCreateCapabilityOptions("encryption"))
+	require.Nil(t, err)
+	assert.False(t, *created.Capability.Encryption.DiskEncryptionSupported)
+}
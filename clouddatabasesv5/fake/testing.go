@@ -0,0 +1,44 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"testing"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// NewTestService : Start a fake server holding the specified deployments, and return it along with a client that
+// sends its requests to it. The server is closed when the test ends.
+func NewTestService(t testing.TB, options *Options, deployments ...clouddatabasesv5.Deployment) (*Server, *clouddatabasesv5.CloudDatabasesV5) {
+	t.Helper()
+	server := NewServer(options)
+	t.Cleanup(server.Close)
+	for _, info := range deployments {
+		server.AddDeployment(info)
+	}
+
+	service, err := clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatalf("cannot create the client of the fake server: %s", err)
+	}
+	return server, service
+}
@@ -0,0 +1,546 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// GuardedClient : Wraps a CloudDatabasesAPI and queues the operations that start a task on a deployment, per
+// deployment ID, once EnableTaskSerialization is called, and retries them when they are refused because of a running
+// task, once EnableTaskConflictRetries is called. The other operations are passed to the wrapped client as is.
+//
+// The wrapped client can be a *CloudDatabasesV5, a *MultiRegionClient or a stub.
+type GuardedClient struct {
+	CloudDatabasesAPI
+
+	taskSerializer           *taskSerializer
+	taskConflictRetryOptions *TaskConflictRetryOptions
+}
+
+var _ CloudDatabasesAPI = (*GuardedClient)(nil)
+
+// NewGuardedClient : constructs an instance of GuardedClient that wraps the specified client.
+func NewGuardedClient(api CloudDatabasesAPI) *GuardedClient {
+	return &GuardedClient{CloudDatabasesAPI: api}
+}
+
+// runTask invokes an operation that starts a task on the deployment, in its turn when task serialization is enabled,
+// and retries it when task conflict retries are enabled. Without a deployment ID, the operation is invoked as is and
+// the wrapped client reports the missing ID.
+func runTask[T taskResult](ctx context.Context, guarded *GuardedClient, id *string, operation func() (T, *core.DetailedResponse, error)) (result T, response *core.DetailedResponse, err error) {
+	if id == nil {
+		return operation()
+	}
+	serialized := func() (result T, response *core.DetailedResponse, err error) {
+		release, err := guarded.acquireDeployment(ctx, *id)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "task-serialization-error")
+			return
+		}
+		defer func() {
+			release(result)
+		}()
+		return operation()
+	}
+	if guarded.taskConflictRetryOptions == nil {
+		return serialized()
+	}
+	return retryTaskConflicts(ctx, guarded, *id, serialized)
+}
+
+// CreateDatabaseUser calls CreateDatabaseUser on the wrapped client, in its turn when task serialization is enabled
+// and retried on task conflicts when task conflict retries are enabled.
+func (guarded *GuardedClient) CreateDatabaseUser(createDatabaseUserOptions *CreateDatabaseUserOptions) (result *CreateDatabaseUserResponse, response *core.DetailedResponse, err error) {
+	return guarded.CreateDatabaseUserWithContext(context.Background(), createDatabaseUserOptions)
+}
+
+// CreateDatabaseUserWithContext is an alternate form of the CreateDatabaseUser method which supports a Context parameter
+func (guarded *GuardedClient) CreateDatabaseUserWithContext(ctx context.Context, createDatabaseUserOptions *CreateDatabaseUserOptions) (result *CreateDatabaseUserResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if createDatabaseUserOptions != nil {
+		id = createDatabaseUserOptions.ID
+	}
+	return runTask(ctx, guarded, id, func() (*CreateDatabaseUserResponse, *core.DetailedResponse, error) {
+		return guarded.CloudDatabasesAPI.CreateDatabaseUserWithContext(ctx, createDatabaseUserOptions)
+	})
+}
+
+// CreateDatabaseUserAndWait : Creates a user based on user type, then wait for the resulting task
+// See CloudDatabasesV5.CreateDatabaseUserAndWait.
+func (guarded *GuardedClient) CreateDatabaseUserAndWait(ctx context.Context, createDatabaseUserOptions *CreateDatabaseUserOptions, waitForTaskOptions *WaitForTaskOptions) (result *CreateDatabaseUserResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = guarded.CreateDatabaseUserWithContext(ctx, createDatabaseUserOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, guarded, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateUser calls UpdateUser on the wrapped client, in its turn when task serialization is enabled
+// and retried on task conflicts when task conflict retries are enabled.
+func (guarded *GuardedClient) UpdateUser(updateUserOptions *UpdateUserOptions) (result *UpdateUserResponse, response *core.DetailedResponse, err error) {
+	return guarded.UpdateUserWithContext(context.Background(), updateUserOptions)
+}
+
+// UpdateUserWithContext is an alternate form of the UpdateUser method which supports a Context parameter
+func (guarded *GuardedClient) UpdateUserWithContext(ctx context.Context, updateUserOptions *UpdateUserOptions) (result *UpdateUserResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if updateUserOptions != nil {
+		id = updateUserOptions.ID
+	}
+	return runTask(ctx, guarded, id, func() (*UpdateUserResponse, *core.DetailedResponse, error) {
+		return guarded.CloudDatabasesAPI.UpdateUserWithContext(ctx, updateUserOptions)
+	})
+}
+
+// UpdateUserAndWait : Update a user's password or role, then wait for the resulting task
+// See CloudDatabasesV5.UpdateUserAndWait.
+func (guarded *GuardedClient) UpdateUserAndWait(ctx context.Context, updateUserOptions *UpdateUserOptions, waitForTaskOptions *WaitForTaskOptions) (result *UpdateUserResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = guarded.UpdateUserWithContext(ctx, updateUserOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, guarded, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteDatabaseUser calls DeleteDatabaseUser on the wrapped client, in its turn when task serialization is enabled
+// and retried on task conflicts when task conflict retries are enabled.
+func (guarded *GuardedClient) DeleteDatabaseUser(deleteDatabaseUserOptions *DeleteDatabaseUserOptions) (result *DeleteDatabaseUserResponse, response *core.DetailedResponse, err error) {
+	return guarded.DeleteDatabaseUserWithContext(context.Background(), deleteDatabaseUserOptions)
+}
+
+// DeleteDatabaseUserWithContext is an alternate form of the DeleteDatabaseUser method which supports a Context parameter
+func (guarded *GuardedClient) DeleteDatabaseUserWithContext(ctx context.Context, deleteDatabaseUserOptions *DeleteDatabaseUserOptions) (result *DeleteDatabaseUserResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if deleteDatabaseUserOptions != nil {
+		id = deleteDatabaseUserOptions.ID
+	}
+	return runTask(ctx, guarded, id, func() (*DeleteDatabaseUserResponse, *core.DetailedResponse, error) {
+		return guarded.CloudDatabasesAPI.DeleteDatabaseUserWithContext(ctx, deleteDatabaseUserOptions)
+	})
+}
+
+// DeleteDatabaseUserAndWait : Deletes a user based on user type, then wait for the resulting task
+// See CloudDatabasesV5.DeleteDatabaseUserAndWait.
+func (guarded *GuardedClient) DeleteDatabaseUserAndWait(ctx context.Context, deleteDatabaseUserOptions *DeleteDatabaseUserOptions, waitForTaskOptions *WaitForTaskOptions) (result *DeleteDatabaseUserResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = guarded.DeleteDatabaseUserWithContext(ctx, deleteDatabaseUserOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, guarded, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateDatabaseConfiguration calls UpdateDatabaseConfiguration on the wrapped client, in its turn when task serialization is enabled
+// and retried on task conflicts when task conflict retries are enabled.
+func (guarded *GuardedClient) UpdateDatabaseConfiguration(updateDatabaseConfigurationOptions *UpdateDatabaseConfigurationOptions) (result *UpdateDatabaseConfigurationResponse, response *core.DetailedResponse, err error) {
+	return guarded.UpdateDatabaseConfigurationWithContext(context.Background(), updateDatabaseConfigurationOptions)
+}
+
+// UpdateDatabaseConfigurationWithContext is an alternate form of the UpdateDatabaseConfiguration method which supports a Context parameter
+func (guarded *GuardedClient) UpdateDatabaseConfigurationWithContext(ctx context.Context, updateDatabaseConfigurationOptions *UpdateDatabaseConfigurationOptions) (result *UpdateDatabaseConfigurationResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if updateDatabaseConfigurationOptions != nil {
+		id = updateDatabaseConfigurationOptions.ID
+	}
+	return runTask(ctx, guarded, id, func() (*UpdateDatabaseConfigurationResponse, *core.DetailedResponse, error) {
+		return guarded.CloudDatabasesAPI.UpdateDatabaseConfigurationWithContext(ctx, updateDatabaseConfigurationOptions)
+	})
+}
+
+// UpdateDatabaseConfigurationAndWait : Change your database configuration, then wait for the resulting task
+// See CloudDatabasesV5.UpdateDatabaseConfigurationAndWait.
+func (guarded *GuardedClient) UpdateDatabaseConfigurationAndWait(ctx context.Context, updateDatabaseConfigurationOptions *UpdateDatabaseConfigurationOptions, waitForTaskOptions *WaitForTaskOptions) (result *UpdateDatabaseConfigurationResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = guarded.UpdateDatabaseConfigurationWithContext(ctx, updateDatabaseConfigurationOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, guarded, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ResyncReplica calls ResyncReplica on the wrapped client, in its turn when task serialization is enabled
+// and retried on task conflicts when task conflict retries are enabled.
+func (guarded *GuardedClient) ResyncReplica(resyncReplicaOptions *ResyncReplicaOptions) (result *ResyncReplicaResponse, response *core.DetailedResponse, err error) {
+	return guarded.ResyncReplicaWithContext(context.Background(), resyncReplicaOptions)
+}
+
+// ResyncReplicaWithContext is an alternate form of the ResyncReplica method which supports a Context parameter
+func (guarded *GuardedClient) ResyncReplicaWithContext(ctx context.Context, resyncReplicaOptions *ResyncReplicaOptions) (result *ResyncReplicaResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if resyncReplicaOptions != nil {
+		id = resyncReplicaOptions.ID
+	}
+	return runTask(ctx, guarded, id, func() (*ResyncReplicaResponse, *core.DetailedResponse, error) {
+		return guarded.CloudDatabasesAPI.ResyncReplicaWithContext(ctx, resyncReplicaOptions)
+	})
+}
+
+// ResyncReplicaAndWait : Resync read-only replica, then wait for the resulting task
+// See CloudDatabasesV5.ResyncReplicaAndWait.
+func (guarded *GuardedClient) ResyncReplicaAndWait(ctx context.Context, resyncReplicaOptions *ResyncReplicaOptions, waitForTaskOptions *WaitForTaskOptions) (result *ResyncReplicaResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = guarded.ResyncReplicaWithContext(ctx, resyncReplicaOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, guarded, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PromoteReadOnlyReplica calls PromoteReadOnlyReplica on the wrapped client, in its turn when task serialization is enabled
+// and retried on task conflicts when task conflict retries are enabled.
+func (guarded *GuardedClient) PromoteReadOnlyReplica(promoteReadOnlyReplicaOptions *PromoteReadOnlyReplicaOptions) (result *PromoteReadOnlyReplicaResponse, response *core.DetailedResponse, err error) {
+	return guarded.PromoteReadOnlyReplicaWithContext(context.Background(), promoteReadOnlyReplicaOptions)
+}
+
+// PromoteReadOnlyReplicaWithContext is an alternate form of the PromoteReadOnlyReplica method which supports a Context parameter
+func (guarded *GuardedClient) PromoteReadOnlyReplicaWithContext(ctx context.Context, promoteReadOnlyReplicaOptions *PromoteReadOnlyReplicaOptions) (result *PromoteReadOnlyReplicaResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if promoteReadOnlyReplicaOptions != nil {
+		id = promoteReadOnlyReplicaOptions.ID
+	}
+	return runTask(ctx, guarded, id, func() (*PromoteReadOnlyReplicaResponse, *core.DetailedResponse, error) {
+		return guarded.CloudDatabasesAPI.PromoteReadOnlyReplicaWithContext(ctx, promoteReadOnlyReplicaOptions)
+	})
+}
+
+// PromoteReadOnlyReplicaAndWait : Promote read-only replica to a full deployment, then wait for the resulting task
+// See CloudDatabasesV5.PromoteReadOnlyReplicaAndWait.
+func (guarded *GuardedClient) PromoteReadOnlyReplicaAndWait(ctx context.Context, promoteReadOnlyReplicaOptions *PromoteReadOnlyReplicaOptions, waitForTaskOptions *WaitForTaskOptions) (result *PromoteReadOnlyReplicaResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = guarded.PromoteReadOnlyReplicaWithContext(ctx, promoteReadOnlyReplicaOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, guarded, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// StartOndemandBackup calls StartOndemandBackup on the wrapped client, in its turn when task serialization is enabled
+// and retried on task conflicts when task conflict retries are enabled.
+func (guarded *GuardedClient) StartOndemandBackup(startOndemandBackupOptions *StartOndemandBackupOptions) (result *StartOndemandBackupResponse, response *core.DetailedResponse, err error) {
+	return guarded.StartOndemandBackupWithContext(context.Background(), startOndemandBackupOptions)
+}
+
+// StartOndemandBackupWithContext is an alternate form of the StartOndemandBackup method which supports a Context parameter
+func (guarded *GuardedClient) StartOndemandBackupWithContext(ctx context.Context, startOndemandBackupOptions *StartOndemandBackupOptions) (result *StartOndemandBackupResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if startOndemandBackupOptions != nil {
+		id = startOndemandBackupOptions.ID
+	}
+	return runTask(ctx, guarded, id, func() (*StartOndemandBackupResponse, *core.DetailedResponse, error) {
+		return guarded.CloudDatabasesAPI.StartOndemandBackupWithContext(ctx, startOndemandBackupOptions)
+	})
+}
+
+// StartOndemandBackupAndWait : Initiate an on-demand backup, then wait for the resulting task
+// See CloudDatabasesV5.StartOndemandBackupAndWait.
+func (guarded *GuardedClient) StartOndemandBackupAndWait(ctx context.Context, startOndemandBackupOptions *StartOndemandBackupOptions, waitForTaskOptions *WaitForTaskOptions) (result *StartOndemandBackupResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = guarded.StartOndemandBackupWithContext(ctx, startOndemandBackupOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, guarded, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SetDeploymentScalingGroup calls SetDeploymentScalingGroup on the wrapped client, in its turn when task serialization is enabled
+// and retried on task conflicts when task conflict retries are enabled.
+func (guarded *GuardedClient) SetDeploymentScalingGroup(setDeploymentScalingGroupOptions *SetDeploymentScalingGroupOptions) (result *SetDeploymentScalingGroupResponse, response *core.DetailedResponse, err error) {
+	return guarded.SetDeploymentScalingGroupWithContext(context.Background(), setDeploymentScalingGroupOptions)
+}
+
+// SetDeploymentScalingGroupWithContext is an alternate form of the SetDeploymentScalingGroup method which supports a Context parameter
+func (guarded *GuardedClient) SetDeploymentScalingGroupWithContext(ctx context.Context, setDeploymentScalingGroupOptions *SetDeploymentScalingGroupOptions) (result *SetDeploymentScalingGroupResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if setDeploymentScalingGroupOptions != nil {
+		id = setDeploymentScalingGroupOptions.ID
+	}
+	return runTask(ctx, guarded, id, func() (*SetDeploymentScalingGroupResponse, *core.DetailedResponse, error) {
+		return guarded.CloudDatabasesAPI.SetDeploymentScalingGroupWithContext(ctx, setDeploymentScalingGroupOptions)
+	})
+}
+
+// SetDeploymentScalingGroupAndWait : Set scaling values on a specified group, then wait for the resulting task
+// See CloudDatabasesV5.SetDeploymentScalingGroupAndWait.
+func (guarded *GuardedClient) SetDeploymentScalingGroupAndWait(ctx context.Context, setDeploymentScalingGroupOptions *SetDeploymentScalingGroupOptions, waitForTaskOptions *WaitForTaskOptions) (result *SetDeploymentScalingGroupResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = guarded.SetDeploymentScalingGroupWithContext(ctx, setDeploymentScalingGroupOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, guarded, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SetAutoscalingConditions calls SetAutoscalingConditions on the wrapped client, in its turn when task serialization is enabled
+// and retried on task conflicts when task conflict retries are enabled.
+func (guarded *GuardedClient) SetAutoscalingConditions(setAutoscalingConditionsOptions *SetAutoscalingConditionsOptions) (result *SetAutoscalingConditionsResponse, response *core.DetailedResponse, err error) {
+	return guarded.SetAutoscalingConditionsWithContext(context.Background(), setAutoscalingConditionsOptions)
+}
+
+// SetAutoscalingConditionsWithContext is an alternate form of the SetAutoscalingConditions method which supports a Context parameter
+func (guarded *GuardedClient) SetAutoscalingConditionsWithContext(ctx context.Context, setAutoscalingConditionsOptions *SetAutoscalingConditionsOptions) (result *SetAutoscalingConditionsResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if setAutoscalingConditionsOptions != nil {
+		id = setAutoscalingConditionsOptions.ID
+	}
+	return runTask(ctx, guarded, id, func() (*SetAutoscalingConditionsResponse, *core.DetailedResponse, error) {
+		return guarded.CloudDatabasesAPI.SetAutoscalingConditionsWithContext(ctx, setAutoscalingConditionsOptions)
+	})
+}
+
+// SetAutoscalingConditionsAndWait : Set the autoscaling configuration from a deployment, then wait for the resulting task
+// See CloudDatabasesV5.SetAutoscalingConditionsAndWait.
+func (guarded *GuardedClient) SetAutoscalingConditionsAndWait(ctx context.Context, setAutoscalingConditionsOptions *SetAutoscalingConditionsOptions, waitForTaskOptions *WaitForTaskOptions) (result *SetAutoscalingConditionsResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = guarded.SetAutoscalingConditionsWithContext(ctx, setAutoscalingConditionsOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, guarded, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// KillConnections calls KillConnections on the wrapped client, in its turn when task serialization is enabled
+// and retried on task conflicts when task conflict retries are enabled.
+func (guarded *GuardedClient) KillConnections(killConnectionsOptions *KillConnectionsOptions) (result *KillConnectionsResponse, response *core.DetailedResponse, err error) {
+	return guarded.KillConnectionsWithContext(context.Background(), killConnectionsOptions)
+}
+
+// KillConnectionsWithContext is an alternate form of the KillConnections method which supports a Context parameter
+func (guarded *GuardedClient) KillConnectionsWithContext(ctx context.Context, killConnectionsOptions *KillConnectionsOptions) (result *KillConnectionsResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if killConnectionsOptions != nil {
+		id = killConnectionsOptions.ID
+	}
+	return runTask(ctx, guarded, id, func() (*KillConnectionsResponse, *core.DetailedResponse, error) {
+		return guarded.CloudDatabasesAPI.KillConnectionsWithContext(ctx, killConnectionsOptions)
+	})
+}
+
+// KillConnectionsAndWait : Kill connections to a PostgreSQL or EnterpriseDB deployment, then wait for the resulting task
+// See CloudDatabasesV5.KillConnectionsAndWait.
+func (guarded *GuardedClient) KillConnectionsAndWait(ctx context.Context, killConnectionsOptions *KillConnectionsOptions, waitForTaskOptions *WaitForTaskOptions) (result *KillConnectionsResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = guarded.KillConnectionsWithContext(ctx, killConnectionsOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, guarded, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateLogicalReplicationSlot calls CreateLogicalReplicationSlot on the wrapped client, in its turn when task serialization is enabled
+// and retried on task conflicts when task conflict retries are enabled.
+func (guarded *GuardedClient) CreateLogicalReplicationSlot(createLogicalReplicationSlotOptions *CreateLogicalReplicationSlotOptions) (result *CreateLogicalReplicationSlotResponse, response *core.DetailedResponse, err error) {
+	return guarded.CreateLogicalReplicationSlotWithContext(context.Background(), createLogicalReplicationSlotOptions)
+}
+
+// CreateLogicalReplicationSlotWithContext is an alternate form of the CreateLogicalReplicationSlot method which supports a Context parameter
+func (guarded *GuardedClient) CreateLogicalReplicationSlotWithContext(ctx context.Context, createLogicalReplicationSlotOptions *CreateLogicalReplicationSlotOptions) (result *CreateLogicalReplicationSlotResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if createLogicalReplicationSlotOptions != nil {
+		id = createLogicalReplicationSlotOptions.ID
+	}
+	return runTask(ctx, guarded, id, func() (*CreateLogicalReplicationSlotResponse, *core.DetailedResponse, error) {
+		return guarded.CloudDatabasesAPI.CreateLogicalReplicationSlotWithContext(ctx, createLogicalReplicationSlotOptions)
+	})
+}
+
+// CreateLogicalReplicationSlotAndWait : Create a new logical replication slot, then wait for the resulting task
+// See CloudDatabasesV5.CreateLogicalReplicationSlotAndWait.
+func (guarded *GuardedClient) CreateLogicalReplicationSlotAndWait(ctx context.Context, createLogicalReplicationSlotOptions *CreateLogicalReplicationSlotOptions, waitForTaskOptions *WaitForTaskOptions) (result *CreateLogicalReplicationSlotResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = guarded.CreateLogicalReplicationSlotWithContext(ctx, createLogicalReplicationSlotOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, guarded, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteLogicalReplicationSlot calls DeleteLogicalReplicationSlot on the wrapped client, in its turn when task serialization is enabled
+// and retried on task conflicts when task conflict retries are enabled.
+func (guarded *GuardedClient) DeleteLogicalReplicationSlot(deleteLogicalReplicationSlotOptions *DeleteLogicalReplicationSlotOptions) (result *DeleteLogicalReplicationSlotResponse, response *core.DetailedResponse, err error) {
+	return guarded.DeleteLogicalReplicationSlotWithContext(context.Background(), deleteLogicalReplicationSlotOptions)
+}
+
+// DeleteLogicalReplicationSlotWithContext is an alternate form of the DeleteLogicalReplicationSlot method which supports a Context parameter
+func (guarded *GuardedClient) DeleteLogicalReplicationSlotWithContext(ctx context.Context, deleteLogicalReplicationSlotOptions *DeleteLogicalReplicationSlotOptions) (result *DeleteLogicalReplicationSlotResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if deleteLogicalReplicationSlotOptions != nil {
+		id = deleteLogicalReplicationSlotOptions.ID
+	}
+	return runTask(ctx, guarded, id, func() (*DeleteLogicalReplicationSlotResponse, *core.DetailedResponse, error) {
+		return guarded.CloudDatabasesAPI.DeleteLogicalReplicationSlotWithContext(ctx, deleteLogicalReplicationSlotOptions)
+	})
+}
+
+// DeleteLogicalReplicationSlotAndWait : Delete a logical replication slot, then wait for the resulting task
+// See CloudDatabasesV5.DeleteLogicalReplicationSlotAndWait.
+func (guarded *GuardedClient) DeleteLogicalReplicationSlotAndWait(ctx context.Context, deleteLogicalReplicationSlotOptions *DeleteLogicalReplicationSlotOptions, waitForTaskOptions *WaitForTaskOptions) (result *DeleteLogicalReplicationSlotResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = guarded.DeleteLogicalReplicationSlotWithContext(ctx, deleteLogicalReplicationSlotOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, guarded, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SetAllowlist calls SetAllowlist on the wrapped client, in its turn when task serialization is enabled
+// and retried on task conflicts when task conflict retries are enabled.
+func (guarded *GuardedClient) SetAllowlist(setAllowlistOptions *SetAllowlistOptions) (result *SetAllowlistResponse, response *core.DetailedResponse, err error) {
+	return guarded.SetAllowlistWithContext(context.Background(), setAllowlistOptions)
+}
+
+// SetAllowlistWithContext is an alternate form of the SetAllowlist method which supports a Context parameter
+func (guarded *GuardedClient) SetAllowlistWithContext(ctx context.Context, setAllowlistOptions *SetAllowlistOptions) (result *SetAllowlistResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if setAllowlistOptions != nil {
+		id = setAllowlistOptions.ID
+	}
+	return runTask(ctx, guarded, id, func() (*SetAllowlistResponse, *core.DetailedResponse, error) {
+		return guarded.CloudDatabasesAPI.SetAllowlistWithContext(ctx, setAllowlistOptions)
+	})
+}
+
+// SetAllowlistAndWait : Set the allowlist for a deployment, then wait for the resulting task
+// See CloudDatabasesV5.SetAllowlistAndWait.
+func (guarded *GuardedClient) SetAllowlistAndWait(ctx context.Context, setAllowlistOptions *SetAllowlistOptions, waitForTaskOptions *WaitForTaskOptions) (result *SetAllowlistResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = guarded.SetAllowlistWithContext(ctx, setAllowlistOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, guarded, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// AddAllowlistEntry calls AddAllowlistEntry on the wrapped client, in its turn when task serialization is enabled
+// and retried on task conflicts when task conflict retries are enabled.
+func (guarded *GuardedClient) AddAllowlistEntry(addAllowlistEntryOptions *AddAllowlistEntryOptions) (result *AddAllowlistEntryResponse, response *core.DetailedResponse, err error) {
+	return guarded.AddAllowlistEntryWithContext(context.Background(), addAllowlistEntryOptions)
+}
+
+// AddAllowlistEntryWithContext is an alternate form of the AddAllowlistEntry method which supports a Context parameter
+func (guarded *GuardedClient) AddAllowlistEntryWithContext(ctx context.Context, addAllowlistEntryOptions *AddAllowlistEntryOptions) (result *AddAllowlistEntryResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if addAllowlistEntryOptions != nil {
+		id = addAllowlistEntryOptions.ID
+	}
+	return runTask(ctx, guarded, id, func() (*AddAllowlistEntryResponse, *core.DetailedResponse, error) {
+		return guarded.CloudDatabasesAPI.AddAllowlistEntryWithContext(ctx, addAllowlistEntryOptions)
+	})
+}
+
+// AddAllowlistEntryAndWait : Add an address or range to the allowlist for a deployment, then wait for the resulting task
+// See CloudDatabasesV5.AddAllowlistEntryAndWait.
+func (guarded *GuardedClient) AddAllowlistEntryAndWait(ctx context.Context, addAllowlistEntryOptions *AddAllowlistEntryOptions, waitForTaskOptions *WaitForTaskOptions) (result *AddAllowlistEntryResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = guarded.AddAllowlistEntryWithContext(ctx, addAllowlistEntryOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, guarded, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteAllowlistEntry calls DeleteAllowlistEntry on the wrapped client, in its turn when task serialization is enabled
+// and retried on task conflicts when task conflict retries are enabled.
+func (guarded *GuardedClient) DeleteAllowlistEntry(deleteAllowlistEntryOptions *DeleteAllowlistEntryOptions) (result *DeleteAllowlistEntryResponse, response *core.DetailedResponse, err error) {
+	return guarded.DeleteAllowlistEntryWithContext(context.Background(), deleteAllowlistEntryOptions)
+}
+
+// DeleteAllowlistEntryWithContext is an alternate form of the DeleteAllowlistEntry method which supports a Context parameter
+func (guarded *GuardedClient) DeleteAllowlistEntryWithContext(ctx context.Context, deleteAllowlistEntryOptions *DeleteAllowlistEntryOptions) (result *DeleteAllowlistEntryResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if deleteAllowlistEntryOptions != nil {
+		id = deleteAllowlistEntryOptions.ID
+	}
+	return runTask(ctx, guarded, id, func() (*DeleteAllowlistEntryResponse, *core.DetailedResponse, error) {
+		return guarded.CloudDatabasesAPI.DeleteAllowlistEntryWithContext(ctx, deleteAllowlistEntryOptions)
+	})
+}
+
+// DeleteAllowlistEntryAndWait : Delete an address or range from the allowlist of a deployment, then wait for the resulting task
+// See CloudDatabasesV5.DeleteAllowlistEntryAndWait.
+func (guarded *GuardedClient) DeleteAllowlistEntryAndWait(ctx context.Context, deleteAllowlistEntryOptions *DeleteAllowlistEntryOptions, waitForTaskOptions *WaitForTaskOptions) (result *DeleteAllowlistEntryResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = guarded.DeleteAllowlistEntryWithContext(ctx, deleteAllowlistEntryOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, guarded, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SetDatabaseInplaceVersionUpgrade calls SetDatabaseInplaceVersionUpgrade on the wrapped client, in its turn when task serialization is enabled
+// and retried on task conflicts when task conflict retries are enabled.
+func (guarded *GuardedClient) SetDatabaseInplaceVersionUpgrade(setDatabaseInplaceVersionUpgradeOptions *SetDatabaseInplaceVersionUpgradeOptions) (result *SetDatabaseInplaceVersionUpgradeResponse, response *core.DetailedResponse, err error) {
+	return guarded.SetDatabaseInplaceVersionUpgradeWithContext(context.Background(), setDatabaseInplaceVersionUpgradeOptions)
+}
+
+// SetDatabaseInplaceVersionUpgradeWithContext is an alternate form of the SetDatabaseInplaceVersionUpgrade method which supports a Context parameter
+func (guarded *GuardedClient) SetDatabaseInplaceVersionUpgradeWithContext(ctx context.Context, setDatabaseInplaceVersionUpgradeOptions *SetDatabaseInplaceVersionUpgradeOptions) (result *SetDatabaseInplaceVersionUpgradeResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if setDatabaseInplaceVersionUpgradeOptions != nil {
+		id = setDatabaseInplaceVersionUpgradeOptions.ID
+	}
+	return runTask(ctx, guarded, id, func() (*SetDatabaseInplaceVersionUpgradeResponse, *core.DetailedResponse, error) {
+		return guarded.CloudDatabasesAPI.SetDatabaseInplaceVersionUpgradeWithContext(ctx, setDatabaseInplaceVersionUpgradeOptions)
+	})
+}
+
+// SetDatabaseInplaceVersionUpgradeAndWait : Upgrade your database version, then wait for the resulting task
+// See CloudDatabasesV5.SetDatabaseInplaceVersionUpgradeAndWait.
+func (guarded *GuardedClient) SetDatabaseInplaceVersionUpgradeAndWait(ctx context.Context, setDatabaseInplaceVersionUpgradeOptions *SetDatabaseInplaceVersionUpgradeOptions, waitForTaskOptions *WaitForTaskOptions) (result *SetDatabaseInplaceVersionUpgradeResponse, task *Task, response *core.DetailedResponse, err error) {
+	result, response, err = guarded.SetDatabaseInplaceVersionUpgradeWithContext(ctx, setDatabaseInplaceVersionUpgradeOptions)
+	if err != nil || result == nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	task, err = waitForResultTask(ctx, guarded, result.Task, waitForTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
@@ -0,0 +1,153 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package declarative holds the file loading and dry-run code shared by the allowlist, configuration and users
+// packages, which compare a resource of a deployment with its desired state kept in a file.
+package declarative
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gopkg.in/yaml.v3"
+)
+
+// Format : The format of a file.
+type Format string
+
+// Constants associated with the Format type.
+const (
+	FormatYAML Format = "yaml"
+	FormatJSON Format = "json"
+	FormatCSV  Format = "csv"
+)
+
+// extensions maps the file extensions to their format.
+var extensions = map[string]Format{
+	".yaml": FormatYAML,
+	".yml":  FormatYAML,
+	".json": FormatJSON,
+	".csv":  FormatCSV,
+}
+
+// FormatForPath returns the format of a file from its extension, if it is one of the supported formats. The kind of
+// file, for example "allowlist", is used in the error message.
+func FormatForPath(path string, kind string, supported ...Format) (Format, error) {
+	format, ok := extensions[strings.ToLower(filepath.Ext(path))]
+	if ok {
+		for _, supportedFormat := range supported {
+			if format == supportedFormat {
+				return format, nil
+			}
+		}
+	}
+	err := fmt.Errorf("cannot tell the %s format of '%s' from its extension", kind, path)
+	return "", core.SDKErrorf(err, "", "unknown-format", common.GetComponentInfo())
+}
+
+// LoadFile opens a file and reads it with load, in the format given by its extension.
+func LoadFile(path string, kind string, supported []Format, load func(reader io.Reader, format Format) error) error {
+	format, err := FormatForPath(path, kind, supported...)
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return core.SDKErrorf(err, "", "open-error", common.GetComponentInfo())
+	}
+	defer file.Close()
+
+	err = load(file, format)
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "load-file-error")
+	}
+	return nil
+}
+
+// Decode reads a YAML or JSON document into target, with the fields named as in the JSON tags of target. Fields
+// target does not have are an error. A document that is a mapping with the single key wrapper is unwrapped first, and
+// an empty document leaves target as it is.
+func Decode(reader io.Reader, format Format, kind string, wrapper string, target interface{}) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return core.SDKErrorf(err, "", "decode-error", common.GetComponentInfo())
+	}
+
+	var document interface{}
+	switch format {
+	case FormatYAML:
+		err = yaml.Unmarshal(data, &document)
+	case FormatJSON:
+		if len(bytes.TrimSpace(data)) > 0 {
+			decoder := json.NewDecoder(bytes.NewReader(data))
+			decoder.UseNumber()
+			err = decoder.Decode(&document)
+		}
+	default:
+		err = fmt.Errorf("unknown %s format '%s'", kind, format)
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "decode-error", common.GetComponentInfo())
+	}
+	if wrapped, ok := document.(map[string]interface{}); ok && len(wrapped) == 1 && wrapped[wrapper] != nil {
+		document = wrapped[wrapper]
+	}
+	if document == nil {
+		return nil
+	}
+
+	buf, err := json.Marshal(document)
+	if err != nil {
+		return core.SDKErrorf(err, "", "decode-error", common.GetComponentInfo())
+	}
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.DisallowUnknownFields()
+	err = decoder.Decode(target)
+	if err != nil {
+		return core.SDKErrorf(err, "", "decode-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// WriteDryRun writes the plan to output. Output is required: a dry run does not pick a destination for the plan.
+func WriteDryRun(output io.Writer, plan fmt.Stringer) error {
+	err := core.ValidateNotNil(output, "Output cannot be nil in dry-run mode")
+	if err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	_, err = io.WriteString(output, plan.String())
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// Sync makes a plan with newPlan and applies it with apply. The plan is returned along with the result of apply.
+func Sync[P any, R any](newPlan func() (P, error), apply func(plan P) (R, error)) (plan P, result R, err error) {
+	plan, err = newPlan()
+	if err != nil {
+		return
+	}
+	result, err = apply(plan)
+	return
+}
@@ -0,0 +1,78 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package declarative
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type entry struct {
+	Name string `json:"name"`
+}
+
+type plan string
+
+func (p plan) String() string {
+	return string(p)
+}
+
+func TestFormatForPath(t *testing.T) {
+	format, err := FormatForPath("dir/users.YML", "users", FormatYAML, FormatJSON)
+	require.Nil(t, err)
+	assert.Equal(t, FormatYAML, format)
+
+	_, err = FormatForPath("users.csv", "users", FormatYAML, FormatJSON)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "cannot tell the users format of 'users.csv'")
+}
+
+func TestDecode(t *testing.T) {
+	documents := map[string]Format{
+		"- name: a\n":                  FormatYAML,
+		"entries:\n  - name: a\n":      FormatYAML,
+		`[{"name": "a"}]`:              FormatJSON,
+		`{"entries": [{"name": "a"}]}`: FormatJSON,
+	}
+	for document, format := range documents {
+		var entries []entry
+		err := Decode(strings.NewReader(document), format, "test", "entries", &entries)
+		require.Nil(t, err, document)
+		assert.Equal(t, []entry{{Name: "a"}}, entries, document)
+	}
+
+	entries := []entry{}
+	require.Nil(t, Decode(strings.NewReader(" \n"), FormatJSON, "test", "entries", &entries))
+	assert.Empty(t, entries)
+
+	assert.NotNil(t, Decode(strings.NewReader("- nmae: a\n"), FormatYAML, "test", "entries", &entries))
+	assert.NotNil(t, Decode(strings.NewReader("name,a\n"), FormatCSV, "test", "entries", &entries))
+}
+
+func TestWriteDryRun(t *testing.T) {
+	output := &bytes.Buffer{}
+	require.Nil(t, WriteDryRun(output, plan("1 to add\n")))
+	assert.Equal(t, "1 to add\n", output.String())
+
+	err := WriteDryRun(nil, plan("1 to add\n"))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Output cannot be nil in dry-run mode")
+}
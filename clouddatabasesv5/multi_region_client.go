@@ -0,0 +1,945 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/cloud-databases-go-sdk/crn"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// MultiRegionClient : Routes each operation to a CloudDatabasesV5 for the region of the deployment it acts on.
+// The region is taken from the CRN in the deployment, task or backup ID of the options. Operations that are not
+// tied to a deployment, or whose ID is not a CRN, use the client of the default region.
+//
+// The per-region clients are clones of the default client, made the first time a region is used, so they share its
+// authenticator and any configuration (HTTP client, retries, default headers) applied to it beforehand.
+type MultiRegionClient struct {
+	client          *CloudDatabasesV5
+	region          string
+	platform        string
+	privateEndpoint bool
+
+	mu      sync.Mutex
+	clients map[string]*CloudDatabasesV5
+}
+
+var _ CloudDatabasesAPI = (*MultiRegionClient)(nil)
+
+// NewMultiRegionClient : constructs an instance of MultiRegionClient with passed in options.
+// Region selects the default region, and Platform and PrivateEndpoint apply to every region. URL cannot be set.
+func NewMultiRegionClient(options *CloudDatabasesV5Options) (multiRegion *MultiRegionClient, err error) {
+	if options.URL != "" {
+		err = core.SDKErrorf(nil, "a URL cannot be set on a multi-region client", "url-not-supported", common.GetComponentInfo())
+		return
+	}
+
+	client, err := NewCloudDatabasesV5(options)
+	err = core.RepurposeSDKProblem(err, "new-client-error")
+	if err != nil {
+		return
+	}
+
+	region := options.Region
+	if region == "" {
+		region = defaultUrlVariables["region"]
+	}
+	multiRegion = &MultiRegionClient{
+		client:          client,
+		region:          region,
+		platform:        options.Platform,
+		privateEndpoint: options.PrivateEndpoint,
+		clients:         map[string]*CloudDatabasesV5{region: client},
+	}
+	return
+}
+
+// Client returns the client of the default region.
+func (multiRegion *MultiRegionClient) Client() *CloudDatabasesV5 {
+	return multiRegion.client
+}
+
+// ClientForRegion returns the client for the specified region, creating it on first use.
+func (multiRegion *MultiRegionClient) ClientForRegion(region string) (*CloudDatabasesV5, error) {
+	multiRegion.mu.Lock()
+	defer multiRegion.mu.Unlock()
+
+	if client, ok := multiRegion.clients[region]; ok {
+		return client, nil
+	}
+
+	serviceURL, err := serviceURLForOptions(&CloudDatabasesV5Options{
+		Region:          region,
+		Platform:        multiRegion.platform,
+		PrivateEndpoint: multiRegion.privateEndpoint,
+	})
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "region-client-error")
+	}
+	client := multiRegion.client.Clone()
+	err = client.SetServiceURL(serviceURL)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "region-client-error")
+	}
+	multiRegion.clients[region] = client
+	return client, nil
+}
+
+// ClientForID returns the client for the region named in the specified CRN. IDs that are not CRNs get the client of
+// the default region.
+func (multiRegion *MultiRegionClient) ClientForID(id string) (*CloudDatabasesV5, error) {
+	if !crn.IsCRN(id) {
+		return multiRegion.client, nil
+	}
+	resourceCRN, err := crn.Parse(id)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "crn-validation-error")
+	}
+	client, err := multiRegion.ClientForRegion(resourceCRN.Region)
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("no client for the region of '%s'", id), "crn-region-error", common.GetComponentInfo())
+	}
+	return client, nil
+}
+
+// clientFor returns the client for the region named in the specified ID, which may be nil.
+func (multiRegion *MultiRegionClient) clientFor(id *string) (*CloudDatabasesV5, error) {
+	if id == nil {
+		return multiRegion.client, nil
+	}
+	return multiRegion.ClientForID(*id)
+}
+
+// ListDeployables calls ListDeployables on the client of the default region.
+func (multiRegion *MultiRegionClient) ListDeployables(listDeployablesOptions *ListDeployablesOptions) (result *ListDeployablesResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.ListDeployablesWithContext(context.Background(), listDeployablesOptions)
+}
+
+// ListDeployablesWithContext is an alternate form of the ListDeployables method which supports a Context parameter
+func (multiRegion *MultiRegionClient) ListDeployablesWithContext(ctx context.Context, listDeployablesOptions *ListDeployablesOptions) (result *ListDeployablesResponse, response *core.DetailedResponse, err error) {
+	client := multiRegion.client
+	return client.ListDeployablesWithContext(ctx, listDeployablesOptions)
+}
+
+// ListRegions calls ListRegions on the client of the default region.
+func (multiRegion *MultiRegionClient) ListRegions(listRegionsOptions *ListRegionsOptions) (result *ListRegionsResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.ListRegionsWithContext(context.Background(), listRegionsOptions)
+}
+
+// ListRegionsWithContext is an alternate form of the ListRegions method which supports a Context parameter
+func (multiRegion *MultiRegionClient) ListRegionsWithContext(ctx context.Context, listRegionsOptions *ListRegionsOptions) (result *ListRegionsResponse, response *core.DetailedResponse, err error) {
+	client := multiRegion.client
+	return client.ListRegionsWithContext(ctx, listRegionsOptions)
+}
+
+// GetDeploymentInfo calls GetDeploymentInfo on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) GetDeploymentInfo(getDeploymentInfoOptions *GetDeploymentInfoOptions) (result *GetDeploymentInfoResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.GetDeploymentInfoWithContext(context.Background(), getDeploymentInfoOptions)
+}
+
+// GetDeploymentInfoWithContext is an alternate form of the GetDeploymentInfo method which supports a Context parameter
+func (multiRegion *MultiRegionClient) GetDeploymentInfoWithContext(ctx context.Context, getDeploymentInfoOptions *GetDeploymentInfoOptions) (result *GetDeploymentInfoResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if getDeploymentInfoOptions != nil {
+		id = getDeploymentInfoOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.GetDeploymentInfoWithContext(ctx, getDeploymentInfoOptions)
+}
+
+// CreateDatabaseUser calls CreateDatabaseUser on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) CreateDatabaseUser(createDatabaseUserOptions *CreateDatabaseUserOptions) (result *CreateDatabaseUserResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.CreateDatabaseUserWithContext(context.Background(), createDatabaseUserOptions)
+}
+
+// CreateDatabaseUserWithContext is an alternate form of the CreateDatabaseUser method which supports a Context parameter
+func (multiRegion *MultiRegionClient) CreateDatabaseUserWithContext(ctx context.Context, createDatabaseUserOptions *CreateDatabaseUserOptions) (result *CreateDatabaseUserResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if createDatabaseUserOptions != nil {
+		id = createDatabaseUserOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.CreateDatabaseUserWithContext(ctx, createDatabaseUserOptions)
+}
+
+// UpdateUser calls UpdateUser on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) UpdateUser(updateUserOptions *UpdateUserOptions) (result *UpdateUserResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.UpdateUserWithContext(context.Background(), updateUserOptions)
+}
+
+// UpdateUserWithContext is an alternate form of the UpdateUser method which supports a Context parameter
+func (multiRegion *MultiRegionClient) UpdateUserWithContext(ctx context.Context, updateUserOptions *UpdateUserOptions) (result *UpdateUserResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if updateUserOptions != nil {
+		id = updateUserOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.UpdateUserWithContext(ctx, updateUserOptions)
+}
+
+// DeleteDatabaseUser calls DeleteDatabaseUser on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) DeleteDatabaseUser(deleteDatabaseUserOptions *DeleteDatabaseUserOptions) (result *DeleteDatabaseUserResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.DeleteDatabaseUserWithContext(context.Background(), deleteDatabaseUserOptions)
+}
+
+// DeleteDatabaseUserWithContext is an alternate form of the DeleteDatabaseUser method which supports a Context parameter
+func (multiRegion *MultiRegionClient) DeleteDatabaseUserWithContext(ctx context.Context, deleteDatabaseUserOptions *DeleteDatabaseUserOptions) (result *DeleteDatabaseUserResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if deleteDatabaseUserOptions != nil {
+		id = deleteDatabaseUserOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.DeleteDatabaseUserWithContext(ctx, deleteDatabaseUserOptions)
+}
+
+// GetDatabaseConfigurationSchema calls GetDatabaseConfigurationSchema on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) GetDatabaseConfigurationSchema(getDatabaseConfigurationSchemaOptions *GetDatabaseConfigurationSchemaOptions) (result *GetDatabaseConfigurationSchemaResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.GetDatabaseConfigurationSchemaWithContext(context.Background(), getDatabaseConfigurationSchemaOptions)
+}
+
+// GetDatabaseConfigurationSchemaWithContext is an alternate form of the GetDatabaseConfigurationSchema method which supports a Context parameter
+func (multiRegion *MultiRegionClient) GetDatabaseConfigurationSchemaWithContext(ctx context.Context, getDatabaseConfigurationSchemaOptions *GetDatabaseConfigurationSchemaOptions) (result *GetDatabaseConfigurationSchemaResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if getDatabaseConfigurationSchemaOptions != nil {
+		id = getDatabaseConfigurationSchemaOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.GetDatabaseConfigurationSchemaWithContext(ctx, getDatabaseConfigurationSchemaOptions)
+}
+
+// UpdateDatabaseConfiguration calls UpdateDatabaseConfiguration on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) UpdateDatabaseConfiguration(updateDatabaseConfigurationOptions *UpdateDatabaseConfigurationOptions) (result *UpdateDatabaseConfigurationResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.UpdateDatabaseConfigurationWithContext(context.Background(), updateDatabaseConfigurationOptions)
+}
+
+// UpdateDatabaseConfigurationWithContext is an alternate form of the UpdateDatabaseConfiguration method which supports a Context parameter
+func (multiRegion *MultiRegionClient) UpdateDatabaseConfigurationWithContext(ctx context.Context, updateDatabaseConfigurationOptions *UpdateDatabaseConfigurationOptions) (result *UpdateDatabaseConfigurationResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if updateDatabaseConfigurationOptions != nil {
+		id = updateDatabaseConfigurationOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.UpdateDatabaseConfigurationWithContext(ctx, updateDatabaseConfigurationOptions)
+}
+
+// ListRemotes calls ListRemotes on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) ListRemotes(listRemotesOptions *ListRemotesOptions) (result *ListRemotesResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.ListRemotesWithContext(context.Background(), listRemotesOptions)
+}
+
+// ListRemotesWithContext is an alternate form of the ListRemotes method which supports a Context parameter
+func (multiRegion *MultiRegionClient) ListRemotesWithContext(ctx context.Context, listRemotesOptions *ListRemotesOptions) (result *ListRemotesResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if listRemotesOptions != nil {
+		id = listRemotesOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.ListRemotesWithContext(ctx, listRemotesOptions)
+}
+
+// ResyncReplica calls ResyncReplica on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) ResyncReplica(resyncReplicaOptions *ResyncReplicaOptions) (result *ResyncReplicaResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.ResyncReplicaWithContext(context.Background(), resyncReplicaOptions)
+}
+
+// ResyncReplicaWithContext is an alternate form of the ResyncReplica method which supports a Context parameter
+func (multiRegion *MultiRegionClient) ResyncReplicaWithContext(ctx context.Context, resyncReplicaOptions *ResyncReplicaOptions) (result *ResyncReplicaResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if resyncReplicaOptions != nil {
+		id = resyncReplicaOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.ResyncReplicaWithContext(ctx, resyncReplicaOptions)
+}
+
+// PromoteReadOnlyReplica calls PromoteReadOnlyReplica on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) PromoteReadOnlyReplica(promoteReadOnlyReplicaOptions *PromoteReadOnlyReplicaOptions) (result *PromoteReadOnlyReplicaResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.PromoteReadOnlyReplicaWithContext(context.Background(), promoteReadOnlyReplicaOptions)
+}
+
+// PromoteReadOnlyReplicaWithContext is an alternate form of the PromoteReadOnlyReplica method which supports a Context parameter
+func (multiRegion *MultiRegionClient) PromoteReadOnlyReplicaWithContext(ctx context.Context, promoteReadOnlyReplicaOptions *PromoteReadOnlyReplicaOptions) (result *PromoteReadOnlyReplicaResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if promoteReadOnlyReplicaOptions != nil {
+		id = promoteReadOnlyReplicaOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.PromoteReadOnlyReplicaWithContext(ctx, promoteReadOnlyReplicaOptions)
+}
+
+// ListDeploymentTasks calls ListDeploymentTasks on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) ListDeploymentTasks(listDeploymentTasksOptions *ListDeploymentTasksOptions) (result *Tasks, response *core.DetailedResponse, err error) {
+	return multiRegion.ListDeploymentTasksWithContext(context.Background(), listDeploymentTasksOptions)
+}
+
+// ListDeploymentTasksWithContext is an alternate form of the ListDeploymentTasks method which supports a Context parameter
+func (multiRegion *MultiRegionClient) ListDeploymentTasksWithContext(ctx context.Context, listDeploymentTasksOptions *ListDeploymentTasksOptions) (result *Tasks, response *core.DetailedResponse, err error) {
+	var id *string
+	if listDeploymentTasksOptions != nil {
+		id = listDeploymentTasksOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.ListDeploymentTasksWithContext(ctx, listDeploymentTasksOptions)
+}
+
+// GetTask calls GetTask on the client for the region of the task.
+func (multiRegion *MultiRegionClient) GetTask(getTaskOptions *GetTaskOptions) (result *GetTaskResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.GetTaskWithContext(context.Background(), getTaskOptions)
+}
+
+// GetTaskWithContext is an alternate form of the GetTask method which supports a Context parameter
+func (multiRegion *MultiRegionClient) GetTaskWithContext(ctx context.Context, getTaskOptions *GetTaskOptions) (result *GetTaskResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if getTaskOptions != nil {
+		id = getTaskOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.GetTaskWithContext(ctx, getTaskOptions)
+}
+
+// GetBackupInfo calls GetBackupInfo on the client for the region of the backup.
+func (multiRegion *MultiRegionClient) GetBackupInfo(getBackupInfoOptions *GetBackupInfoOptions) (result *GetBackupInfoResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.GetBackupInfoWithContext(context.Background(), getBackupInfoOptions)
+}
+
+// GetBackupInfoWithContext is an alternate form of the GetBackupInfo method which supports a Context parameter
+func (multiRegion *MultiRegionClient) GetBackupInfoWithContext(ctx context.Context, getBackupInfoOptions *GetBackupInfoOptions) (result *GetBackupInfoResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if getBackupInfoOptions != nil {
+		id = getBackupInfoOptions.BackupID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.GetBackupInfoWithContext(ctx, getBackupInfoOptions)
+}
+
+// ListDeploymentBackups calls ListDeploymentBackups on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) ListDeploymentBackups(listDeploymentBackupsOptions *ListDeploymentBackupsOptions) (result *Backups, response *core.DetailedResponse, err error) {
+	return multiRegion.ListDeploymentBackupsWithContext(context.Background(), listDeploymentBackupsOptions)
+}
+
+// ListDeploymentBackupsWithContext is an alternate form of the ListDeploymentBackups method which supports a Context parameter
+func (multiRegion *MultiRegionClient) ListDeploymentBackupsWithContext(ctx context.Context, listDeploymentBackupsOptions *ListDeploymentBackupsOptions) (result *Backups, response *core.DetailedResponse, err error) {
+	var id *string
+	if listDeploymentBackupsOptions != nil {
+		id = listDeploymentBackupsOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.ListDeploymentBackupsWithContext(ctx, listDeploymentBackupsOptions)
+}
+
+// StartOndemandBackup calls StartOndemandBackup on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) StartOndemandBackup(startOndemandBackupOptions *StartOndemandBackupOptions) (result *StartOndemandBackupResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.StartOndemandBackupWithContext(context.Background(), startOndemandBackupOptions)
+}
+
+// StartOndemandBackupWithContext is an alternate form of the StartOndemandBackup method which supports a Context parameter
+func (multiRegion *MultiRegionClient) StartOndemandBackupWithContext(ctx context.Context, startOndemandBackupOptions *StartOndemandBackupOptions) (result *StartOndemandBackupResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if startOndemandBackupOptions != nil {
+		id = startOndemandBackupOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.StartOndemandBackupWithContext(ctx, startOndemandBackupOptions)
+}
+
+// GetPitrData calls GetPitrData on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) GetPitrData(getPitrDataOptions *GetPitrDataOptions) (result *GetPitrDataResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.GetPitrDataWithContext(context.Background(), getPitrDataOptions)
+}
+
+// GetPitrDataWithContext is an alternate form of the GetPitrData method which supports a Context parameter
+func (multiRegion *MultiRegionClient) GetPitrDataWithContext(ctx context.Context, getPitrDataOptions *GetPitrDataOptions) (result *GetPitrDataResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if getPitrDataOptions != nil {
+		id = getPitrDataOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.GetPitrDataWithContext(ctx, getPitrDataOptions)
+}
+
+// GetConnection calls GetConnection on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) GetConnection(getConnectionOptions *GetConnectionOptions) (result *GetConnectionResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.GetConnectionWithContext(context.Background(), getConnectionOptions)
+}
+
+// GetConnectionWithContext is an alternate form of the GetConnection method which supports a Context parameter
+func (multiRegion *MultiRegionClient) GetConnectionWithContext(ctx context.Context, getConnectionOptions *GetConnectionOptions) (result *GetConnectionResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if getConnectionOptions != nil {
+		id = getConnectionOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.GetConnectionWithContext(ctx, getConnectionOptions)
+}
+
+// CompleteConnection calls CompleteConnection on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) CompleteConnection(completeConnectionOptions *CompleteConnectionOptions) (result *CompleteConnectionResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.CompleteConnectionWithContext(context.Background(), completeConnectionOptions)
+}
+
+// CompleteConnectionWithContext is an alternate form of the CompleteConnection method which supports a Context parameter
+func (multiRegion *MultiRegionClient) CompleteConnectionWithContext(ctx context.Context, completeConnectionOptions *CompleteConnectionOptions) (result *CompleteConnectionResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if completeConnectionOptions != nil {
+		id = completeConnectionOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.CompleteConnectionWithContext(ctx, completeConnectionOptions)
+}
+
+// ListDeploymentScalingGroups calls ListDeploymentScalingGroups on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) ListDeploymentScalingGroups(listDeploymentScalingGroupsOptions *ListDeploymentScalingGroupsOptions) (result *ListDeploymentScalingGroupsResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.ListDeploymentScalingGroupsWithContext(context.Background(), listDeploymentScalingGroupsOptions)
+}
+
+// ListDeploymentScalingGroupsWithContext is an alternate form of the ListDeploymentScalingGroups method which supports a Context parameter
+func (multiRegion *MultiRegionClient) ListDeploymentScalingGroupsWithContext(ctx context.Context, listDeploymentScalingGroupsOptions *ListDeploymentScalingGroupsOptions) (result *ListDeploymentScalingGroupsResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if listDeploymentScalingGroupsOptions != nil {
+		id = listDeploymentScalingGroupsOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.ListDeploymentScalingGroupsWithContext(ctx, listDeploymentScalingGroupsOptions)
+}
+
+// GetDefaultScalingGroups calls GetDefaultScalingGroups on the client of the default region.
+func (multiRegion *MultiRegionClient) GetDefaultScalingGroups(getDefaultScalingGroupsOptions *GetDefaultScalingGroupsOptions) (result *GetDefaultScalingGroupsResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.GetDefaultScalingGroupsWithContext(context.Background(), getDefaultScalingGroupsOptions)
+}
+
+// GetDefaultScalingGroupsWithContext is an alternate form of the GetDefaultScalingGroups method which supports a Context parameter
+func (multiRegion *MultiRegionClient) GetDefaultScalingGroupsWithContext(ctx context.Context, getDefaultScalingGroupsOptions *GetDefaultScalingGroupsOptions) (result *GetDefaultScalingGroupsResponse, response *core.DetailedResponse, err error) {
+	client := multiRegion.client
+	return client.GetDefaultScalingGroupsWithContext(ctx, getDefaultScalingGroupsOptions)
+}
+
+// SetDeploymentScalingGroup calls SetDeploymentScalingGroup on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) SetDeploymentScalingGroup(setDeploymentScalingGroupOptions *SetDeploymentScalingGroupOptions) (result *SetDeploymentScalingGroupResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.SetDeploymentScalingGroupWithContext(context.Background(), setDeploymentScalingGroupOptions)
+}
+
+// SetDeploymentScalingGroupWithContext is an alternate form of the SetDeploymentScalingGroup method which supports a Context parameter
+func (multiRegion *MultiRegionClient) SetDeploymentScalingGroupWithContext(ctx context.Context, setDeploymentScalingGroupOptions *SetDeploymentScalingGroupOptions) (result *SetDeploymentScalingGroupResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if setDeploymentScalingGroupOptions != nil {
+		id = setDeploymentScalingGroupOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.SetDeploymentScalingGroupWithContext(ctx, setDeploymentScalingGroupOptions)
+}
+
+// GetAutoscalingConditions calls GetAutoscalingConditions on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) GetAutoscalingConditions(getAutoscalingConditionsOptions *GetAutoscalingConditionsOptions) (result *AutoscalingGroup, response *core.DetailedResponse, err error) {
+	return multiRegion.GetAutoscalingConditionsWithContext(context.Background(), getAutoscalingConditionsOptions)
+}
+
+// GetAutoscalingConditionsWithContext is an alternate form of the GetAutoscalingConditions method which supports a Context parameter
+func (multiRegion *MultiRegionClient) GetAutoscalingConditionsWithContext(ctx context.Context, getAutoscalingConditionsOptions *GetAutoscalingConditionsOptions) (result *AutoscalingGroup, response *core.DetailedResponse, err error) {
+	var id *string
+	if getAutoscalingConditionsOptions != nil {
+		id = getAutoscalingConditionsOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.GetAutoscalingConditionsWithContext(ctx, getAutoscalingConditionsOptions)
+}
+
+// SetAutoscalingConditions calls SetAutoscalingConditions on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) SetAutoscalingConditions(setAutoscalingConditionsOptions *SetAutoscalingConditionsOptions) (result *SetAutoscalingConditionsResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.SetAutoscalingConditionsWithContext(context.Background(), setAutoscalingConditionsOptions)
+}
+
+// SetAutoscalingConditionsWithContext is an alternate form of the SetAutoscalingConditions method which supports a Context parameter
+func (multiRegion *MultiRegionClient) SetAutoscalingConditionsWithContext(ctx context.Context, setAutoscalingConditionsOptions *SetAutoscalingConditionsOptions) (result *SetAutoscalingConditionsResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if setAutoscalingConditionsOptions != nil {
+		id = setAutoscalingConditionsOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.SetAutoscalingConditionsWithContext(ctx, setAutoscalingConditionsOptions)
+}
+
+// KillConnections calls KillConnections on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) KillConnections(killConnectionsOptions *KillConnectionsOptions) (result *KillConnectionsResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.KillConnectionsWithContext(context.Background(), killConnectionsOptions)
+}
+
+// KillConnectionsWithContext is an alternate form of the KillConnections method which supports a Context parameter
+func (multiRegion *MultiRegionClient) KillConnectionsWithContext(ctx context.Context, killConnectionsOptions *KillConnectionsOptions) (result *KillConnectionsResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if killConnectionsOptions != nil {
+		id = killConnectionsOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.KillConnectionsWithContext(ctx, killConnectionsOptions)
+}
+
+// CreateLogicalReplicationSlot calls CreateLogicalReplicationSlot on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) CreateLogicalReplicationSlot(createLogicalReplicationSlotOptions *CreateLogicalReplicationSlotOptions) (result *CreateLogicalReplicationSlotResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.CreateLogicalReplicationSlotWithContext(context.Background(), createLogicalReplicationSlotOptions)
+}
+
+// CreateLogicalReplicationSlotWithContext is an alternate form of the CreateLogicalReplicationSlot method which supports a Context parameter
+func (multiRegion *MultiRegionClient) CreateLogicalReplicationSlotWithContext(ctx context.Context, createLogicalReplicationSlotOptions *CreateLogicalReplicationSlotOptions) (result *CreateLogicalReplicationSlotResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if createLogicalReplicationSlotOptions != nil {
+		id = createLogicalReplicationSlotOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.CreateLogicalReplicationSlotWithContext(ctx, createLogicalReplicationSlotOptions)
+}
+
+// DeleteLogicalReplicationSlot calls DeleteLogicalReplicationSlot on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) DeleteLogicalReplicationSlot(deleteLogicalReplicationSlotOptions *DeleteLogicalReplicationSlotOptions) (result *DeleteLogicalReplicationSlotResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.DeleteLogicalReplicationSlotWithContext(context.Background(), deleteLogicalReplicationSlotOptions)
+}
+
+// DeleteLogicalReplicationSlotWithContext is an alternate form of the DeleteLogicalReplicationSlot method which supports a Context parameter
+func (multiRegion *MultiRegionClient) DeleteLogicalReplicationSlotWithContext(ctx context.Context, deleteLogicalReplicationSlotOptions *DeleteLogicalReplicationSlotOptions) (result *DeleteLogicalReplicationSlotResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if deleteLogicalReplicationSlotOptions != nil {
+		id = deleteLogicalReplicationSlotOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.DeleteLogicalReplicationSlotWithContext(ctx, deleteLogicalReplicationSlotOptions)
+}
+
+// GetAllowlist calls GetAllowlist on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) GetAllowlist(getAllowlistOptions *GetAllowlistOptions) (result *GetAllowlistResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.GetAllowlistWithContext(context.Background(), getAllowlistOptions)
+}
+
+// GetAllowlistWithContext is an alternate form of the GetAllowlist method which supports a Context parameter
+func (multiRegion *MultiRegionClient) GetAllowlistWithContext(ctx context.Context, getAllowlistOptions *GetAllowlistOptions) (result *GetAllowlistResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if getAllowlistOptions != nil {
+		id = getAllowlistOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.GetAllowlistWithContext(ctx, getAllowlistOptions)
+}
+
+// SetAllowlist calls SetAllowlist on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) SetAllowlist(setAllowlistOptions *SetAllowlistOptions) (result *SetAllowlistResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.SetAllowlistWithContext(context.Background(), setAllowlistOptions)
+}
+
+// SetAllowlistWithContext is an alternate form of the SetAllowlist method which supports a Context parameter
+func (multiRegion *MultiRegionClient) SetAllowlistWithContext(ctx context.Context, setAllowlistOptions *SetAllowlistOptions) (result *SetAllowlistResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if setAllowlistOptions != nil {
+		id = setAllowlistOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.SetAllowlistWithContext(ctx, setAllowlistOptions)
+}
+
+// AddAllowlistEntry calls AddAllowlistEntry on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) AddAllowlistEntry(addAllowlistEntryOptions *AddAllowlistEntryOptions) (result *AddAllowlistEntryResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.AddAllowlistEntryWithContext(context.Background(), addAllowlistEntryOptions)
+}
+
+// AddAllowlistEntryWithContext is an alternate form of the AddAllowlistEntry method which supports a Context parameter
+func (multiRegion *MultiRegionClient) AddAllowlistEntryWithContext(ctx context.Context, addAllowlistEntryOptions *AddAllowlistEntryOptions) (result *AddAllowlistEntryResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if addAllowlistEntryOptions != nil {
+		id = addAllowlistEntryOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.AddAllowlistEntryWithContext(ctx, addAllowlistEntryOptions)
+}
+
+// DeleteAllowlistEntry calls DeleteAllowlistEntry on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) DeleteAllowlistEntry(deleteAllowlistEntryOptions *DeleteAllowlistEntryOptions) (result *DeleteAllowlistEntryResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.DeleteAllowlistEntryWithContext(context.Background(), deleteAllowlistEntryOptions)
+}
+
+// DeleteAllowlistEntryWithContext is an alternate form of the DeleteAllowlistEntry method which supports a Context parameter
+func (multiRegion *MultiRegionClient) DeleteAllowlistEntryWithContext(ctx context.Context, deleteAllowlistEntryOptions *DeleteAllowlistEntryOptions) (result *DeleteAllowlistEntryResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if deleteAllowlistEntryOptions != nil {
+		id = deleteAllowlistEntryOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.DeleteAllowlistEntryWithContext(ctx, deleteAllowlistEntryOptions)
+}
+
+// CreateCapability calls CreateCapability on the client for the location of the deployment or backup, if that
+// location is a supported region, and otherwise on the client of the default region.
+func (multiRegion *MultiRegionClient) CreateCapability(createCapabilityOptions *CreateCapabilityOptions) (result *CreateCapabilityResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.CreateCapabilityWithContext(context.Background(), createCapabilityOptions)
+}
+
+// CreateCapabilityWithContext is an alternate form of the CreateCapability method which supports a Context parameter
+func (multiRegion *MultiRegionClient) CreateCapabilityWithContext(ctx context.Context, createCapabilityOptions *CreateCapabilityOptions) (result *CreateCapabilityResponse, response *core.DetailedResponse, err error) {
+	var location *string
+	if createCapabilityOptions != nil {
+		if createCapabilityOptions.Deployment != nil {
+			location = createCapabilityOptions.Deployment.Location
+		} else if createCapabilityOptions.Backup != nil {
+			location = createCapabilityOptions.Backup.Location
+		}
+	}
+	client := multiRegion.client
+	if location != nil && IsSupportedRegion(*location) {
+		client, err = multiRegion.ClientForRegion(*location)
+		if err != nil {
+			return
+		}
+	}
+	return client.CreateCapabilityWithContext(ctx, createCapabilityOptions)
+}
+
+// GetDeploymentCapability calls GetDeploymentCapability on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) GetDeploymentCapability(getDeploymentCapabilityOptions *GetDeploymentCapabilityOptions) (result *GetDeploymentCapabilityResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.GetDeploymentCapabilityWithContext(context.Background(), getDeploymentCapabilityOptions)
+}
+
+// GetDeploymentCapabilityWithContext is an alternate form of the GetDeploymentCapability method which supports a Context parameter
+func (multiRegion *MultiRegionClient) GetDeploymentCapabilityWithContext(ctx context.Context, getDeploymentCapabilityOptions *GetDeploymentCapabilityOptions) (result *GetDeploymentCapabilityResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if getDeploymentCapabilityOptions != nil {
+		id = getDeploymentCapabilityOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.GetDeploymentCapabilityWithContext(ctx, getDeploymentCapabilityOptions)
+}
+
+// SetDatabaseInplaceVersionUpgrade calls SetDatabaseInplaceVersionUpgrade on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) SetDatabaseInplaceVersionUpgrade(setDatabaseInplaceVersionUpgradeOptions *SetDatabaseInplaceVersionUpgradeOptions) (result *SetDatabaseInplaceVersionUpgradeResponse, response *core.DetailedResponse, err error) {
+	return multiRegion.SetDatabaseInplaceVersionUpgradeWithContext(context.Background(), setDatabaseInplaceVersionUpgradeOptions)
+}
+
+// SetDatabaseInplaceVersionUpgradeWithContext is an alternate form of the SetDatabaseInplaceVersionUpgrade method which supports a Context parameter
+func (multiRegion *MultiRegionClient) SetDatabaseInplaceVersionUpgradeWithContext(ctx context.Context, setDatabaseInplaceVersionUpgradeOptions *SetDatabaseInplaceVersionUpgradeOptions) (result *SetDatabaseInplaceVersionUpgradeResponse, response *core.DetailedResponse, err error) {
+	var id *string
+	if setDatabaseInplaceVersionUpgradeOptions != nil {
+		id = setDatabaseInplaceVersionUpgradeOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.SetDatabaseInplaceVersionUpgradeWithContext(ctx, setDatabaseInplaceVersionUpgradeOptions)
+}
+
+// WaitForTask calls WaitForTask on the client for the region of the task.
+func (multiRegion *MultiRegionClient) WaitForTask(ctx context.Context, taskID string, waitForTaskOptions *WaitForTaskOptions) (result *Task, err error) {
+	client, err := multiRegion.ClientForID(taskID)
+	if err != nil {
+		return
+	}
+	return client.WaitForTask(ctx, taskID, waitForTaskOptions)
+}
+
+// CreateDatabaseUserAndWait calls CreateDatabaseUserAndWait on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) CreateDatabaseUserAndWait(ctx context.Context, createDatabaseUserOptions *CreateDatabaseUserOptions, waitForTaskOptions *WaitForTaskOptions) (result *CreateDatabaseUserResponse, task *Task, response *core.DetailedResponse, err error) {
+	var id *string
+	if createDatabaseUserOptions != nil {
+		id = createDatabaseUserOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.CreateDatabaseUserAndWait(ctx, createDatabaseUserOptions, waitForTaskOptions)
+}
+
+// UpdateUserAndWait calls UpdateUserAndWait on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) UpdateUserAndWait(ctx context.Context, updateUserOptions *UpdateUserOptions, waitForTaskOptions *WaitForTaskOptions) (result *UpdateUserResponse, task *Task, response *core.DetailedResponse, err error) {
+	var id *string
+	if updateUserOptions != nil {
+		id = updateUserOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.UpdateUserAndWait(ctx, updateUserOptions, waitForTaskOptions)
+}
+
+// DeleteDatabaseUserAndWait calls DeleteDatabaseUserAndWait on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) DeleteDatabaseUserAndWait(ctx context.Context, deleteDatabaseUserOptions *DeleteDatabaseUserOptions, waitForTaskOptions *WaitForTaskOptions) (result *DeleteDatabaseUserResponse, task *Task, response *core.DetailedResponse, err error) {
+	var id *string
+	if deleteDatabaseUserOptions != nil {
+		id = deleteDatabaseUserOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.DeleteDatabaseUserAndWait(ctx, deleteDatabaseUserOptions, waitForTaskOptions)
+}
+
+// UpdateDatabaseConfigurationAndWait calls UpdateDatabaseConfigurationAndWait on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) UpdateDatabaseConfigurationAndWait(ctx context.Context, updateDatabaseConfigurationOptions *UpdateDatabaseConfigurationOptions, waitForTaskOptions *WaitForTaskOptions) (result *UpdateDatabaseConfigurationResponse, task *Task, response *core.DetailedResponse, err error) {
+	var id *string
+	if updateDatabaseConfigurationOptions != nil {
+		id = updateDatabaseConfigurationOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.UpdateDatabaseConfigurationAndWait(ctx, updateDatabaseConfigurationOptions, waitForTaskOptions)
+}
+
+// ResyncReplicaAndWait calls ResyncReplicaAndWait on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) ResyncReplicaAndWait(ctx context.Context, resyncReplicaOptions *ResyncReplicaOptions, waitForTaskOptions *WaitForTaskOptions) (result *ResyncReplicaResponse, task *Task, response *core.DetailedResponse, err error) {
+	var id *string
+	if resyncReplicaOptions != nil {
+		id = resyncReplicaOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.ResyncReplicaAndWait(ctx, resyncReplicaOptions, waitForTaskOptions)
+}
+
+// PromoteReadOnlyReplicaAndWait calls PromoteReadOnlyReplicaAndWait on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) PromoteReadOnlyReplicaAndWait(ctx context.Context, promoteReadOnlyReplicaOptions *PromoteReadOnlyReplicaOptions, waitForTaskOptions *WaitForTaskOptions) (result *PromoteReadOnlyReplicaResponse, task *Task, response *core.DetailedResponse, err error) {
+	var id *string
+	if promoteReadOnlyReplicaOptions != nil {
+		id = promoteReadOnlyReplicaOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.PromoteReadOnlyReplicaAndWait(ctx, promoteReadOnlyReplicaOptions, waitForTaskOptions)
+}
+
+// StartOndemandBackupAndWait calls StartOndemandBackupAndWait on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) StartOndemandBackupAndWait(ctx context.Context, startOndemandBackupOptions *StartOndemandBackupOptions, waitForTaskOptions *WaitForTaskOptions) (result *StartOndemandBackupResponse, task *Task, response *core.DetailedResponse, err error) {
+	var id *string
+	if startOndemandBackupOptions != nil {
+		id = startOndemandBackupOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.StartOndemandBackupAndWait(ctx, startOndemandBackupOptions, waitForTaskOptions)
+}
+
+// SetDeploymentScalingGroupAndWait calls SetDeploymentScalingGroupAndWait on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) SetDeploymentScalingGroupAndWait(ctx context.Context, setDeploymentScalingGroupOptions *SetDeploymentScalingGroupOptions, waitForTaskOptions *WaitForTaskOptions) (result *SetDeploymentScalingGroupResponse, task *Task, response *core.DetailedResponse, err error) {
+	var id *string
+	if setDeploymentScalingGroupOptions != nil {
+		id = setDeploymentScalingGroupOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.SetDeploymentScalingGroupAndWait(ctx, setDeploymentScalingGroupOptions, waitForTaskOptions)
+}
+
+// SetAutoscalingConditionsAndWait calls SetAutoscalingConditionsAndWait on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) SetAutoscalingConditionsAndWait(ctx context.Context, setAutoscalingConditionsOptions *SetAutoscalingConditionsOptions, waitForTaskOptions *WaitForTaskOptions) (result *SetAutoscalingConditionsResponse, task *Task, response *core.DetailedResponse, err error) {
+	var id *string
+	if setAutoscalingConditionsOptions != nil {
+		id = setAutoscalingConditionsOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.SetAutoscalingConditionsAndWait(ctx, setAutoscalingConditionsOptions, waitForTaskOptions)
+}
+
+// KillConnectionsAndWait calls KillConnectionsAndWait on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) KillConnectionsAndWait(ctx context.Context, killConnectionsOptions *KillConnectionsOptions, waitForTaskOptions *WaitForTaskOptions) (result *KillConnectionsResponse, task *Task, response *core.DetailedResponse, err error) {
+	var id *string
+	if killConnectionsOptions != nil {
+		id = killConnectionsOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.KillConnectionsAndWait(ctx, killConnectionsOptions, waitForTaskOptions)
+}
+
+// CreateLogicalReplicationSlotAndWait calls CreateLogicalReplicationSlotAndWait on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) CreateLogicalReplicationSlotAndWait(ctx context.Context, createLogicalReplicationSlotOptions *CreateLogicalReplicationSlotOptions, waitForTaskOptions *WaitForTaskOptions) (result *CreateLogicalReplicationSlotResponse, task *Task, response *core.DetailedResponse, err error) {
+	var id *string
+	if createLogicalReplicationSlotOptions != nil {
+		id = createLogicalReplicationSlotOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.CreateLogicalReplicationSlotAndWait(ctx, createLogicalReplicationSlotOptions, waitForTaskOptions)
+}
+
+// DeleteLogicalReplicationSlotAndWait calls DeleteLogicalReplicationSlotAndWait on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) DeleteLogicalReplicationSlotAndWait(ctx context.Context, deleteLogicalReplicationSlotOptions *DeleteLogicalReplicationSlotOptions, waitForTaskOptions *WaitForTaskOptions) (result *DeleteLogicalReplicationSlotResponse, task *Task, response *core.DetailedResponse, err error) {
+	var id *string
+	if deleteLogicalReplicationSlotOptions != nil {
+		id = deleteLogicalReplicationSlotOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.DeleteLogicalReplicationSlotAndWait(ctx, deleteLogicalReplicationSlotOptions, waitForTaskOptions)
+}
+
+// SetAllowlistAndWait calls SetAllowlistAndWait on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) SetAllowlistAndWait(ctx context.Context, setAllowlistOptions *SetAllowlistOptions, waitForTaskOptions *WaitForTaskOptions) (result *SetAllowlistResponse, task *Task, response *core.DetailedResponse, err error) {
+	var id *string
+	if setAllowlistOptions != nil {
+		id = setAllowlistOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.SetAllowlistAndWait(ctx, setAllowlistOptions, waitForTaskOptions)
+}
+
+// AddAllowlistEntryAndWait calls AddAllowlistEntryAndWait on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) AddAllowlistEntryAndWait(ctx context.Context, addAllowlistEntryOptions *AddAllowlistEntryOptions, waitForTaskOptions *WaitForTaskOptions) (result *AddAllowlistEntryResponse, task *Task, response *core.DetailedResponse, err error) {
+	var id *string
+	if addAllowlistEntryOptions != nil {
+		id = addAllowlistEntryOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.AddAllowlistEntryAndWait(ctx, addAllowlistEntryOptions, waitForTaskOptions)
+}
+
+// DeleteAllowlistEntryAndWait calls DeleteAllowlistEntryAndWait on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) DeleteAllowlistEntryAndWait(ctx context.Context, deleteAllowlistEntryOptions *DeleteAllowlistEntryOptions, waitForTaskOptions *WaitForTaskOptions) (result *DeleteAllowlistEntryResponse, task *Task, response *core.DetailedResponse, err error) {
+	var id *string
+	if deleteAllowlistEntryOptions != nil {
+		id = deleteAllowlistEntryOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.DeleteAllowlistEntryAndWait(ctx, deleteAllowlistEntryOptions, waitForTaskOptions)
+}
+
+// SetDatabaseInplaceVersionUpgradeAndWait calls SetDatabaseInplaceVersionUpgradeAndWait on the client for the region of the deployment.
+func (multiRegion *MultiRegionClient) SetDatabaseInplaceVersionUpgradeAndWait(ctx context.Context, setDatabaseInplaceVersionUpgradeOptions *SetDatabaseInplaceVersionUpgradeOptions, waitForTaskOptions *WaitForTaskOptions) (result *SetDatabaseInplaceVersionUpgradeResponse, task *Task, response *core.DetailedResponse, err error) {
+	var id *string
+	if setDatabaseInplaceVersionUpgradeOptions != nil {
+		id = setDatabaseInplaceVersionUpgradeOptions.ID
+	}
+	client, err := multiRegion.clientFor(id)
+	if err != nil {
+		return
+	}
+	return client.SetDatabaseInplaceVersionUpgradeAndWait(ctx, setDatabaseInplaceVersionUpgradeOptions, waitForTaskOptions)
+}
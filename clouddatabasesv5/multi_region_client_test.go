@@ -0,0 +1,130 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// hostRecorder answers every request with an empty JSON object and records the URL it was sent to.
+type hostRecorder struct {
+	mu   sync.Mutex
+	urls []string
+}
+
+func (recorder *hostRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorder.mu.Lock()
+	recorder.urls = append(recorder.urls, req.URL.Scheme+"://"+req.URL.Host+req.URL.EscapedPath())
+	recorder.mu.Unlock()
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{}`)),
+		Request:    req,
+	}, nil
+}
+
+var _ = Describe(`MultiRegionClient`, func() {
+	const euDeployment = "crn:v1:bluemix:public:databases-for-postgresql:eu-de:a/274074dce64e9c423ffc238516c755e1:29caf0e7-120f-4da8-9551-3abf57ebcfc7::"
+	const tokDeployment = "crn:v1:bluemix:public:databases-for-redis:jp-tok:a/274074dce64e9c423ffc238516c755e1:0b8c37b0-0f01-421a-bb32-056c6565b461::"
+	const tokTask = "crn:v1:bluemix:public:databases-for-redis:jp-tok:a/274074dce64e9c423ffc238516c755e1:0b8c37b0-0f01-421a-bb32-056c6565b461:task:3dc480bd-0cd9-4db7-9e2f-7e4b7d0ee2e5"
+
+	var recorder *hostRecorder
+	var multiRegion *clouddatabasesv5.MultiRegionClient
+
+	BeforeEach(func() {
+		var err error
+		multiRegion, err = clouddatabasesv5.NewMultiRegionClient(&clouddatabasesv5.CloudDatabasesV5Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		recorder = &hostRecorder{}
+		multiRegion.Client().Service.SetHTTPClient(&http.Client{Transport: recorder})
+	})
+
+	It(`Route operations by the region of the deployment CRN`, func() {
+		_, _, err := multiRegion.GetDeploymentInfo(&clouddatabasesv5.GetDeploymentInfoOptions{ID: core.StringPtr(euDeployment)})
+		Expect(err).To(BeNil())
+		_, _, err = multiRegion.GetAllowlistWithContext(context.Background(), &clouddatabasesv5.GetAllowlistOptions{ID: core.StringPtr(tokDeployment)})
+		Expect(err).To(BeNil())
+		_, _, err = multiRegion.GetTask(&clouddatabasesv5.GetTaskOptions{ID: core.StringPtr(tokTask)})
+		Expect(err).To(BeNil())
+		_, _, err = multiRegion.ListRegions(&clouddatabasesv5.ListRegionsOptions{})
+		Expect(err).To(BeNil())
+		_, _, err = multiRegion.GetDeploymentInfo(&clouddatabasesv5.GetDeploymentInfoOptions{ID: core.StringPtr("not-a-crn")})
+		Expect(err).To(BeNil())
+
+		Expect(recorder.urls).To(HaveLen(5))
+		Expect(recorder.urls[0]).To(HavePrefix("https://api.eu-de.databases.cloud.ibm.com/v5/ibm/deployments/crn:v1:bluemix:public:databases-for-postgresql:eu-de:"))
+		Expect(recorder.urls[1]).To(HavePrefix("https://api.jp-tok.databases.cloud.ibm.com/v5/ibm/deployments/"))
+		Expect(recorder.urls[2]).To(HavePrefix("https://api.jp-tok.databases.cloud.ibm.com/v5/ibm/tasks/"))
+		Expect(recorder.urls[3]).To(Equal("https://api.us-south.databases.cloud.ibm.com/v5/ibm/regions"))
+		Expect(recorder.urls[4]).To(HavePrefix("https://api.us-south.databases.cloud.ibm.com/v5/ibm/deployments/not-a-crn"))
+	})
+	It(`Build one clone per region that shares the authenticator`, func() {
+		euClient, err := multiRegion.ClientForRegion("eu-de")
+		Expect(err).To(BeNil())
+		Expect(euClient.GetServiceURL()).To(Equal("https://api.eu-de.databases.cloud.ibm.com/v5/ibm"))
+		Expect(euClient.Service != multiRegion.Client().Service).To(BeTrue())
+		Expect(euClient.Service.Options.Authenticator).To(BeIdenticalTo(multiRegion.Client().Service.Options.Authenticator))
+
+		sameClient, err := multiRegion.ClientForID(euDeployment)
+		Expect(err).To(BeNil())
+		Expect(sameClient).To(BeIdenticalTo(euClient))
+
+		defaultClient, err := multiRegion.ClientForRegion("us-south")
+		Expect(err).To(BeNil())
+		Expect(defaultClient).To(BeIdenticalTo(multiRegion.Client()))
+	})
+	It(`Use the platform and private endpoint options for every region`, func() {
+		privateMultiRegion, err := clouddatabasesv5.NewMultiRegionClient(&clouddatabasesv5.CloudDatabasesV5Options{
+			Authenticator:   &core.NoAuthAuthenticator{},
+			Region:          "eu-de",
+			PrivateEndpoint: true,
+		})
+		Expect(err).To(BeNil())
+		Expect(privateMultiRegion.Client().GetServiceURL()).To(Equal("https://api.eu-de.private.databases.cloud.ibm.com/v5/ibm"))
+
+		tokClient, err := privateMultiRegion.ClientForID(tokDeployment)
+		Expect(err).To(BeNil())
+		Expect(tokClient.GetServiceURL()).To(Equal("https://api.jp-tok.private.databases.cloud.ibm.com/v5/ibm"))
+	})
+	It(`Return an error for a CRN in an unsupported region`, func() {
+		_, _, err := multiRegion.GetDeploymentInfo(&clouddatabasesv5.GetDeploymentInfoOptions{
+			ID: core.StringPtr("crn:v1:bluemix:public:databases-for-redis:mars-north:a/274074dce64e9c423ffc238516c755e1:0b8c37b0-0f01-421a-bb32-056c6565b461::"),
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(recorder.urls).To(BeEmpty())
+	})
+	It(`Instantiate client with error: URL set`, func() {
+		client, err := clouddatabasesv5.NewMultiRegionClient(&clouddatabasesv5.CloudDatabasesV5Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+			URL:           "https://clouddatabasesv5/api",
+		})
+		Expect(client).To(BeNil())
+		Expect(err).ToNot(BeNil())
+	})
+})
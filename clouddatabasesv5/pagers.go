@@ -0,0 +1,340 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultPageSize is the number of items returned by each GetNext call of a pager, unless set with SetPageSize.
+const DefaultPageSize = 50
+
+// The list operations of the API return every item in one response. A pager makes that single request on its first
+// GetNext call and then hands out the matching items a page at a time, so reporting code can stop early and does not
+// have to filter or sort the whole list itself.
+
+// listPager : The paging logic shared by the pagers of this package.
+type listPager[T any] struct {
+	list     func(ctx context.Context) ([]T, error)
+	pageSize int
+	items    []T
+	fetched  bool
+	offset   int
+}
+
+func newListPager[T any](list func(ctx context.Context) ([]T, error)) *listPager[T] {
+	return &listPager[T]{
+		list:     list,
+		pageSize: DefaultPageSize,
+	}
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *listPager[T]) HasNext() bool {
+	return !pager.fetched || pager.offset < len(pager.items)
+}
+
+// SetPageSize sets the number of items returned by each GetNext call.
+func (pager *listPager[T]) SetPageSize(pageSize int) {
+	if pageSize > 0 {
+		pager.pageSize = pageSize
+	}
+}
+
+// GetNextWithContext returns the next page of results.
+func (pager *listPager[T]) GetNextWithContext(ctx context.Context) (page []T, err error) {
+	if !pager.HasNext() {
+		err = core.SDKErrorf(fmt.Errorf("no more results available"), "", "no-more-results", common.GetComponentInfo())
+		return
+	}
+	if !pager.fetched {
+		pager.items, err = pager.list(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		pager.fetched = true
+	}
+
+	end := pager.offset + pager.pageSize
+	if end > len(pager.items) {
+		end = len(pager.items)
+	}
+	page = pager.items[pager.offset:end]
+	pager.offset = end
+	return
+}
+
+// GetAllWithContext returns all remaining results.
+func (pager *listPager[T]) GetAllWithContext(ctx context.Context) (allItems []T, err error) {
+	for pager.HasNext() {
+		var nextPage []T
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *listPager[T]) GetNext() (page []T, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *listPager[T]) GetAll() (allItems []T, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreatedAtRange : A client-side filter on the creation time of tasks or backups. A zero bound leaves that side of
+// the range open.
+type CreatedAtRange struct {
+	// Match items created at or after this time.
+	Since time.Time
+
+	// Match items created before this time.
+	Before time.Time
+}
+
+// Matches returns true if createdAt is within the range. Items without a creation time only match an open range.
+func (createdAtRange CreatedAtRange) Matches(createdAt *strfmt.DateTime) bool {
+	if createdAtRange.Since.IsZero() && createdAtRange.Before.IsZero() {
+		return true
+	}
+	if createdAt == nil {
+		return false
+	}
+	t := time.Time(*createdAt)
+	if !createdAtRange.Since.IsZero() && t.Before(createdAtRange.Since) {
+		return false
+	}
+	if !createdAtRange.Before.IsZero() && !t.Before(createdAtRange.Before) {
+		return false
+	}
+	return true
+}
+
+// TaskFilter : Client-side filter for the tasks of a deployment. Empty fields match every task.
+type TaskFilter struct {
+	// Match tasks with one of these statuses, such as TaskStatusFailedConst.
+	Status []string
+
+	// Match tasks with one of these resource types, such as TaskResourceTypeBackupConst.
+	ResourceType []string
+
+	CreatedAt CreatedAtRange
+}
+
+// Matches returns true if the task passes the filter.
+func (filter *TaskFilter) Matches(task *Task) bool {
+	if filter == nil {
+		return true
+	}
+	return matchesAny(filter.Status, task.Status) &&
+		matchesAny(filter.ResourceType, task.ResourceType) &&
+		filter.CreatedAt.Matches(task.CreatedAt)
+}
+
+// BackupFilter : Client-side filter for the backups of a deployment. Empty fields match every backup.
+type BackupFilter struct {
+	// Match backups of one of these types, such as BackupTypeOnDemandConst.
+	Type []string
+
+	// Match backups with one of these statuses, such as BackupStatusCompletedConst.
+	Status []string
+
+	CreatedAt CreatedAtRange
+}
+
+// Matches returns true if the backup passes the filter.
+func (filter *BackupFilter) Matches(backup *Backup) bool {
+	if filter == nil {
+		return true
+	}
+	return matchesAny(filter.Type, backup.Type) &&
+		matchesAny(filter.Status, backup.Status) &&
+		filter.CreatedAt.Matches(backup.CreatedAt)
+}
+
+// DeploymentTasksPager : Pages through the tasks of a deployment that match a filter, newest first.
+type DeploymentTasksPager struct {
+	*listPager[Task]
+}
+
+// NewDeploymentTasksPager returns a new DeploymentTasksPager instance. A nil filter matches every task.
+func (cloudDatabases *CloudDatabasesV5) NewDeploymentTasksPager(listDeploymentTasksOptions *ListDeploymentTasksOptions, filter *TaskFilter) (pager *DeploymentTasksPager, err error) {
+	err = validatePagerOptions(listDeploymentTasksOptions, "listDeploymentTasksOptions")
+	if err != nil {
+		return
+	}
+	options := *listDeploymentTasksOptions
+	pager = &DeploymentTasksPager{newListPager(func(ctx context.Context) ([]Task, error) {
+		result, _, err := cloudDatabases.ListDeploymentTasksWithContext(ctx, &options)
+		if err != nil || result == nil {
+			return nil, err
+		}
+		var tasks []Task
+		for i := range result.Tasks {
+			if filter.Matches(&result.Tasks[i]) {
+				tasks = append(tasks, result.Tasks[i])
+			}
+		}
+		sortNewestFirst(tasks, func(task *Task) *strfmt.DateTime { return task.CreatedAt })
+		return tasks, nil
+	})}
+	return
+}
+
+// DeploymentBackupsPager : Pages through the backups of a deployment that match a filter, newest first.
+type DeploymentBackupsPager struct {
+	*listPager[Backup]
+}
+
+// NewDeploymentBackupsPager returns a new DeploymentBackupsPager instance. A nil filter matches every backup.
+func (cloudDatabases *CloudDatabasesV5) NewDeploymentBackupsPager(listDeploymentBackupsOptions *ListDeploymentBackupsOptions, filter *BackupFilter) (pager *DeploymentBackupsPager, err error) {
+	err = validatePagerOptions(listDeploymentBackupsOptions, "listDeploymentBackupsOptions")
+	if err != nil {
+		return
+	}
+	options := *listDeploymentBackupsOptions
+	pager = &DeploymentBackupsPager{newListPager(func(ctx context.Context) ([]Backup, error) {
+		result, _, err := cloudDatabases.ListDeploymentBackupsWithContext(ctx, &options)
+		if err != nil || result == nil {
+			return nil, err
+		}
+		var backups []Backup
+		for i := range result.Backups {
+			if filter.Matches(&result.Backups[i]) {
+				backups = append(backups, result.Backups[i])
+			}
+		}
+		sortNewestFirst(backups, func(backup *Backup) *strfmt.DateTime { return backup.CreatedAt })
+		return backups, nil
+	})}
+	return
+}
+
+// Remote : A deployment in the read-only replica topology of a deployment.
+type Remote struct {
+	// ID of the deployment.
+	ID string
+
+	// Indicates the deployment is the leader of the replicas.
+	Leader bool
+}
+
+// RemotesPager : Pages through the remotes of a deployment, the leader first.
+type RemotesPager struct {
+	*listPager[Remote]
+}
+
+// NewRemotesPager returns a new RemotesPager instance.
+func (cloudDatabases *CloudDatabasesV5) NewRemotesPager(listRemotesOptions *ListRemotesOptions) (pager *RemotesPager, err error) {
+	err = validatePagerOptions(listRemotesOptions, "listRemotesOptions")
+	if err != nil {
+		return
+	}
+	options := *listRemotesOptions
+	pager = &RemotesPager{newListPager(func(ctx context.Context) ([]Remote, error) {
+		result, _, err := cloudDatabases.ListRemotesWithContext(ctx, &options)
+		if err != nil || result == nil || result.Remotes == nil {
+			return nil, err
+		}
+		var remotes []Remote
+		if leader := core.StringNilMapper(result.Remotes.Leader); leader != "" {
+			remotes = append(remotes, Remote{ID: leader, Leader: true})
+		}
+		for _, replica := range result.Remotes.Replicas {
+			remotes = append(remotes, Remote{ID: replica})
+		}
+		return remotes, nil
+	})}
+	return
+}
+
+// DeployablesPager : Pages through the deployable databases.
+type DeployablesPager struct {
+	*listPager[Deployables]
+}
+
+// NewDeployablesPager returns a new DeployablesPager instance. A nil listDeployablesOptions is allowed.
+func (cloudDatabases *CloudDatabasesV5) NewDeployablesPager(listDeployablesOptions *ListDeployablesOptions) (pager *DeployablesPager, err error) {
+	options := ListDeployablesOptions{}
+	if listDeployablesOptions != nil {
+		options = *listDeployablesOptions
+	}
+	pager = &DeployablesPager{newListPager(func(ctx context.Context) ([]Deployables, error) {
+		result, _, err := cloudDatabases.ListDeployablesWithContext(ctx, &options)
+		if err != nil || result == nil {
+			return nil, err
+		}
+		return result.Deployables, nil
+	})}
+	return
+}
+
+func validatePagerOptions(options interface{}, name string) error {
+	err := core.ValidateNotNil(options, name+" cannot be nil")
+	if err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	err = core.ValidateStruct(options, name)
+	if err != nil {
+		return core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func matchesAny(values []string, value *string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	if value == nil {
+		return false
+	}
+	for _, v := range values {
+		if v == *value {
+			return true
+		}
+	}
+	return false
+}
+
+// sortNewestFirst sorts items by creation time, newest first. Items without a creation time go last.
+func sortNewestFirst[T any](items []T, createdAt func(*T) *strfmt.DateTime) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := createdAt(&items[i]), createdAt(&items[j])
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return time.Time(*a).After(time.Time(*b))
+	})
+}
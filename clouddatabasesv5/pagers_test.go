@@ -0,0 +1,168 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Pagers`, func() {
+	var testServer *httptest.Server
+	var cloudDatabasesService *clouddatabasesv5.CloudDatabasesV5
+	var requestCount int
+
+	BeforeEach(func() {
+		requestCount = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requestCount++
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.URL.EscapedPath() {
+			case "/deployments/testString/tasks":
+				fmt.Fprint(res, `{"tasks": [
+					{"id": "t1", "status": "completed", "resource_type": "backup", "created_at": "2026-01-01T00:00:00.000Z"},
+					{"id": "t2", "status": "failed", "resource_type": "backup", "created_at": "2026-01-03T00:00:00.000Z"},
+					{"id": "t3", "status": "completed", "resource_type": "user", "created_at": "2026-01-02T00:00:00.000Z"},
+					{"id": "t4", "status": "running", "resource_type": "backup"},
+					{"id": "t5", "status": "completed", "resource_type": "backup", "created_at": "2026-01-05T00:00:00.000Z"}
+				]}`)
+			case "/deployments/testString/backups":
+				fmt.Fprint(res, `{"backups": [
+					{"id": "b1", "type": "scheduled", "status": "completed", "created_at": "2026-01-01T00:00:00.000Z"},
+					{"id": "b2", "type": "on_demand", "status": "completed", "created_at": "2026-01-02T00:00:00.000Z"},
+					{"id": "b3", "type": "scheduled", "status": "failed", "created_at": "2026-01-03T00:00:00.000Z"},
+					{"id": "b4", "type": "scheduled", "status": "completed", "created_at": "2026-01-04T00:00:00.000Z"}
+				]}`)
+			case "/deployments/testString/remotes":
+				fmt.Fprint(res, `{"remotes": {"leader": "leader-id", "replicas": ["replica-1", "replica-2"]}}`)
+			case "/deployables":
+				fmt.Fprint(res, `{"deployables": [{"type": "postgresql"}, {"type": "redis"}, {"type": "mysql"}]}`)
+			}
+		}))
+		var serviceErr error
+		cloudDatabasesService, serviceErr = clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	Describe(`DeploymentTasksPager`, func() {
+		It(`Page through filtered tasks, newest first, with one request`, func() {
+			pager, err := cloudDatabasesService.NewDeploymentTasksPager(cloudDatabasesService.NewListDeploymentTasksOptions("testString"), &clouddatabasesv5.TaskFilter{
+				ResourceType: []string{clouddatabasesv5.TaskResourceTypeBackupConst},
+			})
+			Expect(err).To(BeNil())
+			pager.SetPageSize(2)
+
+			Expect(pager.HasNext()).To(BeTrue())
+			page, err := pager.GetNext()
+			Expect(err).To(BeNil())
+			Expect(taskIDs(page)).To(Equal([]string{"t5", "t2"}))
+			page, err = pager.GetNext()
+			Expect(err).To(BeNil())
+			Expect(taskIDs(page)).To(Equal([]string{"t1", "t4"}))
+			Expect(pager.HasNext()).To(BeFalse())
+
+			_, err = pager.GetNext()
+			Expect(err).ToNot(BeNil())
+			Expect(requestCount).To(Equal(1))
+		})
+		It(`Filter by status and creation time`, func() {
+			pager, err := cloudDatabasesService.NewDeploymentTasksPager(cloudDatabasesService.NewListDeploymentTasksOptions("testString"), &clouddatabasesv5.TaskFilter{
+				Status: []string{clouddatabasesv5.TaskStatusCompletedConst, clouddatabasesv5.TaskStatusFailedConst},
+				CreatedAt: clouddatabasesv5.CreatedAtRange{
+					Since:  time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+					Before: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC),
+				},
+			})
+			Expect(err).To(BeNil())
+			tasks, err := pager.GetAll()
+			Expect(err).To(BeNil())
+			Expect(taskIDs(tasks)).To(Equal([]string{"t2", "t3"}))
+		})
+		It(`Return an error for invalid options`, func() {
+			pager, err := cloudDatabasesService.NewDeploymentTasksPager(nil, nil)
+			Expect(pager).To(BeNil())
+			Expect(err).ToNot(BeNil())
+			pager, err = cloudDatabasesService.NewDeploymentTasksPager(&clouddatabasesv5.ListDeploymentTasksOptions{}, nil)
+			Expect(pager).To(BeNil())
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`DeploymentBackupsPager`, func() {
+		It(`Filter backups by type and status`, func() {
+			pager, err := cloudDatabasesService.NewDeploymentBackupsPager(cloudDatabasesService.NewListDeploymentBackupsOptions("testString"), &clouddatabasesv5.BackupFilter{
+				Type:   []string{clouddatabasesv5.BackupTypeScheduledConst},
+				Status: []string{clouddatabasesv5.BackupStatusCompletedConst},
+			})
+			Expect(err).To(BeNil())
+			backups, err := pager.GetAll()
+			Expect(err).To(BeNil())
+			Expect(backups).To(HaveLen(2))
+			Expect(*backups[0].ID).To(Equal("b4"))
+			Expect(*backups[1].ID).To(Equal("b1"))
+		})
+	})
+	Describe(`RemotesPager`, func() {
+		It(`List the leader first`, func() {
+			pager, err := cloudDatabasesService.NewRemotesPager(cloudDatabasesService.NewListRemotesOptions("testString"))
+			Expect(err).To(BeNil())
+			remotes, err := pager.GetAll()
+			Expect(err).To(BeNil())
+			Expect(remotes).To(Equal([]clouddatabasesv5.Remote{
+				{ID: "leader-id", Leader: true},
+				{ID: "replica-1"},
+				{ID: "replica-2"},
+			}))
+		})
+	})
+	Describe(`DeployablesPager`, func() {
+		It(`Page through the deployables`, func() {
+			pager, err := cloudDatabasesService.NewDeployablesPager(nil)
+			Expect(err).To(BeNil())
+			pager.SetPageSize(2)
+			page, err := pager.GetNext()
+			Expect(err).To(BeNil())
+			Expect(page).To(HaveLen(2))
+			Expect(pager.HasNext()).To(BeTrue())
+			page, err = pager.GetNext()
+			Expect(err).To(BeNil())
+			Expect(*page[0].Type).To(Equal("mysql"))
+			Expect(pager.HasNext()).To(BeFalse())
+		})
+	})
+})
+
+func taskIDs(tasks []clouddatabasesv5.Task) []string {
+	var ids []string
+	for _, task := range tasks {
+		ids = append(ids, *task.ID)
+	}
+	return ids
+}
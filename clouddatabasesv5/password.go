@@ -0,0 +1,274 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AutoGeneratePassword can be passed as the password of NewUserDatabaseUser, NewUserRedisDatabaseUser,
+// NewUserOpsManagerUser and NewUserUpdatePasswordSetting to have a password generated for the policy of the user type.
+// The generated password is set in the Password field of the returned model. It can never be a valid password itself.
+const AutoGeneratePassword = "*auto-generate*"
+
+// ErrInvalidPassword is wrapped by every error returned for a password that does not comply with its policy.
+var ErrInvalidPassword = errors.New("invalid password")
+
+const (
+	passwordLowercase = "abcdefghijklmnopqrstuvwxyz"
+	passwordUppercase = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordDigits    = "0123456789"
+)
+
+// PasswordPolicy : The rules that the password of a user must follow.
+type PasswordPolicy struct {
+	// The minimum length of the password.
+	MinLength int
+
+	// The maximum length of the password. Generated passwords have this length.
+	MaxLength int
+
+	// The minimum number of lowercase letters.
+	MinLowercase int
+
+	// The minimum number of uppercase letters.
+	MinUppercase int
+
+	// The minimum number of letters, lowercase or uppercase.
+	MinLetters int
+
+	// The minimum number of digits.
+	MinDigits int
+
+	// The minimum number of special characters.
+	MinSpecial int
+
+	// The special characters allowed in addition to letters and digits.
+	Special string
+
+	// Whether the password must start with a letter or a digit.
+	NoLeadingSpecial bool
+}
+
+// DatabasePasswordPolicy is the policy of the database and read_only_replica users, including Redis users: 15 to 32
+// letters, digits, '-' or '_', with at least one letter and one digit, not starting with a special character.
+var DatabasePasswordPolicy = PasswordPolicy{
+	MinLength:        15,
+	MaxLength:        32,
+	MinLetters:       1,
+	MinDigits:        1,
+	Special:          "-_",
+	NoLeadingSpecial: true,
+}
+
+// OpsManagerPasswordPolicy is the policy of the ops_manager users: the database policy, with at least one lowercase
+// letter, one uppercase letter and one special character. Passwords that follow it also follow DatabasePasswordPolicy.
+var OpsManagerPasswordPolicy = PasswordPolicy{
+	MinLength:        15,
+	MaxLength:        32,
+	MinLowercase:     1,
+	MinUppercase:     1,
+	MinLetters:       2,
+	MinDigits:        1,
+	MinSpecial:       1,
+	Special:          "-_",
+	NoLeadingSpecial: true,
+}
+
+// PasswordPolicyForUserType returns the password policy of the user type. Types other than "ops_manager" use
+// DatabasePasswordPolicy.
+func PasswordPolicyForUserType(userType string) PasswordPolicy {
+	if userType == "ops_manager" {
+		return OpsManagerPasswordPolicy
+	}
+	return DatabasePasswordPolicy
+}
+
+// Validate returns an error wrapping ErrInvalidPassword that lists every rule of the policy the password breaks.
+func (policy PasswordPolicy) Validate(password string) error {
+	var lowercase, uppercase, digits, special int
+	var invalid []string
+	for _, r := range password {
+		switch {
+		case strings.ContainsRune(passwordLowercase, r):
+			lowercase++
+		case strings.ContainsRune(passwordUppercase, r):
+			uppercase++
+		case strings.ContainsRune(passwordDigits, r):
+			digits++
+		case strings.ContainsRune(policy.Special, r):
+			special++
+		default:
+			invalid = append(invalid, fmt.Sprintf("%q", r))
+		}
+	}
+
+	var reasons []string
+	length := len([]rune(password))
+	if length < policy.MinLength || (policy.MaxLength > 0 && length > policy.MaxLength) {
+		reasons = append(reasons, fmt.Sprintf("must be %d to %d characters long", policy.MinLength, policy.MaxLength))
+	}
+	if invalid != nil {
+		reasons = append(reasons, "must not contain "+strings.Join(invalid, ", "))
+	}
+	minimums := []struct {
+		count int
+		min   int
+		kind  string
+	}{
+		{lowercase, policy.MinLowercase, "lowercase letter"},
+		{uppercase, policy.MinUppercase, "uppercase letter"},
+		{lowercase + uppercase, policy.MinLetters, "letter"},
+		{digits, policy.MinDigits, "digit"},
+		{special, policy.MinSpecial, "special character"},
+	}
+	for _, minimum := range minimums {
+		if minimum.count < minimum.min {
+			reasons = append(reasons, fmt.Sprintf("must contain at least %d %s", minimum.min, minimum.kind))
+		}
+	}
+	if policy.NoLeadingSpecial && password != "" && strings.ContainsRune(policy.Special, []rune(password)[0]) {
+		reasons = append(reasons, "must not start with a special character")
+	}
+
+	if reasons != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidPassword, strings.Join(reasons, ", "))
+	}
+	return nil
+}
+
+// GeneratePassword returns a random password of policy.MaxLength characters that follows the policy, using
+// crypto/rand.
+func GeneratePassword(policy PasswordPolicy) (password string, err error) {
+	if policy.MaxLength < policy.MinLength || policy.MaxLength <= 0 {
+		err = core.SDKErrorf(nil, "the maximum length of the password policy must be positive and at least its minimum length", "invalid-password-policy", common.GetComponentInfo())
+		return
+	}
+	letters := passwordLowercase + passwordUppercase
+	all := letters + passwordDigits + policy.Special
+
+	// Draw the required characters first, then fill up and shuffle.
+	var classes []string
+	for _, required := range []struct {
+		min     int
+		charset string
+	}{
+		{policy.MinLowercase, passwordLowercase},
+		{policy.MinUppercase, passwordUppercase},
+		{policy.MinLetters - policy.MinLowercase - policy.MinUppercase, letters},
+		{policy.MinDigits, passwordDigits},
+		{policy.MinSpecial, policy.Special},
+	} {
+		if required.min > 0 && required.charset == "" {
+			err = core.SDKErrorf(nil, "the password policy requires special characters but allows none", "invalid-password-policy", common.GetComponentInfo())
+			return
+		}
+		for i := 0; i < required.min; i++ {
+			classes = append(classes, required.charset)
+		}
+	}
+	if len(classes) > policy.MaxLength || (policy.NoLeadingSpecial && policy.MinSpecial >= policy.MaxLength) {
+		err = core.SDKErrorf(nil, "the password policy requires more characters than its maximum length", "invalid-password-policy", common.GetComponentInfo())
+		return
+	}
+	for len(classes) < policy.MaxLength {
+		classes = append(classes, all)
+	}
+
+	chars := make([]byte, len(classes))
+	for i, charset := range classes {
+		var index int
+		if index, err = randomIndex(len(charset)); err != nil {
+			return
+		}
+		chars[i] = charset[index]
+	}
+	// Fisher-Yates shuffle, retried until the password does not start with a special character. At least one
+	// character is not special, so this ends quickly.
+	for {
+		for i := len(chars) - 1; i > 0; i-- {
+			var j int
+			if j, err = randomIndex(i + 1); err != nil {
+				return
+			}
+			chars[i], chars[j] = chars[j], chars[i]
+		}
+		if !policy.NoLeadingSpecial || !strings.ContainsRune(policy.Special, rune(chars[0])) {
+			break
+		}
+	}
+	password = string(chars)
+	return
+}
+
+func randomIndex(n int) (int, error) {
+	index, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, core.SDKErrorf(err, "", "random-error", common.GetComponentInfo())
+	}
+	return int(index.Int64()), nil
+}
+
+// generatePassword returns the password as is, or a generated one if it is AutoGeneratePassword.
+func generatePassword(password string, policy PasswordPolicy) (string, error) {
+	if password != AutoGeneratePassword {
+		return password, nil
+	}
+	generated, err := GeneratePassword(policy)
+	if err != nil {
+		return "", core.RepurposeSDKProblem(err, "generate-password-error")
+	}
+	return generated, nil
+}
+
+func validatePassword(password *string, policy PasswordPolicy) error {
+	if password == nil {
+		return core.SDKErrorf(fmt.Errorf("%w: no password", ErrInvalidPassword), "", "invalid-password", common.GetComponentInfo())
+	}
+	if err := policy.Validate(*password); err != nil {
+		return core.SDKErrorf(err, "", "invalid-password", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// ValidatePassword checks the password of the user against DatabasePasswordPolicy.
+func (user *UserDatabaseUser) ValidatePassword() error {
+	return validatePassword(user.Password, DatabasePasswordPolicy)
+}
+
+// ValidatePassword checks the password of the user against DatabasePasswordPolicy.
+func (user *UserRedisDatabaseUser) ValidatePassword() error {
+	return validatePassword(user.Password, DatabasePasswordPolicy)
+}
+
+// ValidatePassword checks the password of the user against OpsManagerPasswordPolicy.
+func (user *UserOpsManagerUser) ValidatePassword() error {
+	return validatePassword(user.Password, OpsManagerPasswordPolicy)
+}
+
+// ValidatePassword checks the new password against the policy of the type of the updated user, as returned by
+// PasswordPolicyForUserType.
+func (setting *UserUpdatePasswordSetting) ValidatePassword(userType string) error {
+	return validatePassword(setting.Password, PasswordPolicyForUserType(userType))
+}
@@ -0,0 +1,113 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Passwords`, func() {
+	service := (*clouddatabasesv5.CloudDatabasesV5)(nil)
+
+	It(`Generate passwords that follow the policy`, func() {
+		for _, policy := range []clouddatabasesv5.PasswordPolicy{clouddatabasesv5.DatabasePasswordPolicy, clouddatabasesv5.OpsManagerPasswordPolicy} {
+			seen := map[string]bool{}
+			for i := 0; i < 50; i++ {
+				password, err := clouddatabasesv5.GeneratePassword(policy)
+				Expect(err).To(BeNil())
+				Expect(password).To(HaveLen(policy.MaxLength))
+				Expect(policy.Validate(password)).To(BeNil(), password)
+				Expect(seen[password]).To(BeFalse())
+				seen[password] = true
+			}
+		}
+
+		password, err := clouddatabasesv5.GeneratePassword(clouddatabasesv5.OpsManagerPasswordPolicy)
+		Expect(err).To(BeNil())
+		Expect(clouddatabasesv5.DatabasePasswordPolicy.Validate(password)).To(BeNil())
+	})
+	It(`Refuse impossible policies`, func() {
+		policies := []clouddatabasesv5.PasswordPolicy{
+			{MinLength: 15},
+			{MinLength: 15, MaxLength: 10},
+			{MinLength: 2, MaxLength: 3, MinDigits: 2, MinLetters: 2},
+			{MinLength: 2, MaxLength: 3, MinSpecial: 1},
+			{MinLength: 2, MaxLength: 2, MinSpecial: 2, Special: "-", NoLeadingSpecial: true},
+		}
+		for _, policy := range policies {
+			_, err := clouddatabasesv5.GeneratePassword(policy)
+			Expect(err).ToNot(BeNil(), "%+v", policy)
+		}
+	})
+	It(`Report every broken rule`, func() {
+		Expect(clouddatabasesv5.DatabasePasswordPolicy.Validate("abcdefghij12345")).To(BeNil())
+		Expect(clouddatabasesv5.DatabasePasswordPolicy.Validate("abc-def_ghij-12345")).To(BeNil())
+
+		err := clouddatabasesv5.DatabasePasswordPolicy.Validate("-short!")
+		Expect(errors.Is(err, clouddatabasesv5.ErrInvalidPassword)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("must be 15 to 32 characters long"))
+		Expect(err.Error()).To(ContainSubstring(`must not contain '!'`))
+		Expect(err.Error()).To(ContainSubstring("must contain at least 1 digit"))
+		Expect(err.Error()).To(ContainSubstring("must not start with a special character"))
+
+		err = clouddatabasesv5.OpsManagerPasswordPolicy.Validate("abcdefghij12345")
+		Expect(errors.Is(err, clouddatabasesv5.ErrInvalidPassword)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("must contain at least 1 uppercase letter"))
+		Expect(err.Error()).To(ContainSubstring("must contain at least 1 special character"))
+		Expect(clouddatabasesv5.OpsManagerPasswordPolicy.Validate("Abcdefghij_12345")).To(BeNil())
+
+		Expect(clouddatabasesv5.DatabasePasswordPolicy.Validate(strings.Repeat("a1", 17))).ToNot(BeNil())
+	})
+	It(`Generate passwords in the model constructors`, func() {
+		databaseUser, err := service.NewUserDatabaseUser("app", clouddatabasesv5.AutoGeneratePassword)
+		Expect(err).To(BeNil())
+		Expect(*databaseUser.Password).ToNot(Equal(clouddatabasesv5.AutoGeneratePassword))
+		Expect(databaseUser.ValidatePassword()).To(BeNil())
+
+		redisUser, err := service.NewUserRedisDatabaseUser("cache", clouddatabasesv5.AutoGeneratePassword)
+		Expect(err).To(BeNil())
+		Expect(redisUser.ValidatePassword()).To(BeNil())
+
+		opsManagerUser, err := service.NewUserOpsManagerUser("ops", clouddatabasesv5.AutoGeneratePassword)
+		Expect(err).To(BeNil())
+		Expect(opsManagerUser.ValidatePassword()).To(BeNil())
+
+		setting, err := service.NewUserUpdatePasswordSetting(clouddatabasesv5.AutoGeneratePassword)
+		Expect(err).To(BeNil())
+		Expect(setting.ValidatePassword("database")).To(BeNil())
+		Expect(setting.ValidatePassword("ops_manager")).To(BeNil())
+
+		databaseUser, err = service.NewUserDatabaseUser("app", "given-password-1")
+		Expect(err).To(BeNil())
+		Expect(*databaseUser.Password).To(Equal("given-password-1"))
+	})
+	It(`Validate the password of each user type`, func() {
+		Expect((&clouddatabasesv5.UserDatabaseUser{Password: core.StringPtr("password")}).ValidatePassword()).ToNot(BeNil())
+		Expect((&clouddatabasesv5.UserRedisDatabaseUser{}).ValidatePassword()).ToNot(BeNil())
+		err := (&clouddatabasesv5.UserOpsManagerUser{Password: core.StringPtr("abcdefghij12345")}).ValidatePassword()
+		Expect(errors.Is(err, clouddatabasesv5.ErrInvalidPassword)).To(BeTrue())
+		setting := &clouddatabasesv5.UserUpdatePasswordSetting{Password: core.StringPtr("abcdefghij12345")}
+		Expect(setting.ValidatePassword("database")).To(BeNil())
+		Expect(setting.ValidatePassword("ops_manager")).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,267 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Redacted replaces secrets in the String, GoString and LogValue output of models, in MarshalRedacted output and in
+// the debug messages of NewRedactingLogger.
+const Redacted = "[redacted]"
+
+// reURIPassword matches the password of a URI with user info, such as the composed connection strings.
+var reURIPassword = regexp.MustCompile(`([A-Za-z][A-Za-z0-9+.-]*://[^:@/\s"']*:)[^@/\s"']*@`)
+
+// RedactSecrets returns the input with secrets redacted: the secrets redacted by core.RedactSecrets, such as
+// Authorization headers and "password" JSON fields, and the passwords of URIs.
+func RedactSecrets(input string) string {
+	return redactURIs(core.RedactSecrets(input))
+}
+
+func redactURIs(input string) string {
+	return reURIPassword.ReplaceAllString(input, "${1}"+Redacted+"@")
+}
+
+// MarshalRedacted returns the JSON encoding of v with the "password" fields and the passwords of URIs redacted. Use
+// it for dumps: the models themselves are marshalled with their secrets, which the service requires. Object keys are
+// sorted.
+func MarshalRedacted(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "marshal-error", common.GetComponentInfo())
+	}
+	var document interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err = decoder.Decode(&document); err != nil {
+		return nil, core.SDKErrorf(err, "", "unmarshal-error", common.GetComponentInfo())
+	}
+	data, err = json.Marshal(redactJSON(document))
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "marshal-error", common.GetComponentInfo())
+	}
+	return data, nil
+}
+
+func redactJSON(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if _, ok := field.(string); ok && strings.EqualFold(key, "password") {
+				value[key] = Redacted
+			} else {
+				value[key] = redactJSON(field)
+			}
+		}
+	case []interface{}:
+		for i, element := range value {
+			value[i] = redactJSON(element)
+		}
+	case string:
+		return redactURIs(value)
+	}
+	return value
+}
+
+// redactedField is a field of a model as it is formatted: the value pointed to, or Redacted for a secret.
+type redactedField struct {
+	name  string
+	value interface{}
+	isNil bool
+}
+
+// redactedFields returns the exported fields of the model struct, with their "password" fields redacted.
+func redactedFields(model interface{}) (fields []redactedField) {
+	value := reflect.ValueOf(model)
+	for i := 0; i < value.NumField(); i++ {
+		structField := value.Type().Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+		field := redactedField{name: structField.Name}
+		fieldValue := value.Field(i)
+		switch {
+		case fieldValue.Kind() == reflect.Ptr && fieldValue.IsNil():
+			field.isNil = true
+			field.value = fieldValue.Interface()
+		case strings.Split(structField.Tag.Get("json"), ",")[0] == "password":
+			field.value = Redacted
+		case fieldValue.Kind() == reflect.Ptr:
+			field.value = fieldValue.Elem().Interface()
+		default:
+			field.value = fieldValue.Interface()
+		}
+		fields = append(fields, field)
+	}
+	return
+}
+
+// redactedString formats the model like the %+v verb, with pointers dereferenced and secrets redacted.
+func redactedString(model interface{}) string {
+	fields := redactedFields(model)
+	formatted := make([]string, len(fields))
+	for i, field := range fields {
+		if field.isNil {
+			formatted[i] = field.name + ":<nil>"
+		} else {
+			formatted[i] = fmt.Sprintf("%s:%v", field.name, field.value)
+		}
+	}
+	return "{" + strings.Join(formatted, " ") + "}"
+}
+
+// redactedGoString formats the model like the %#v verb, with pointers dereferenced and secrets redacted.
+func redactedGoString(model interface{}) string {
+	fields := redactedFields(model)
+	formatted := make([]string, len(fields))
+	for i, field := range fields {
+		formatted[i] = fmt.Sprintf("%s:%#v", field.name, field.value)
+	}
+	return fmt.Sprintf("%T{%s}", model, strings.Join(formatted, ", "))
+}
+
+// String returns the fields of the options, with the password redacted.
+func (options CompleteConnectionOptions) String() string {
+	return redactedString(options)
+}
+
+// GoString returns the fields of the options, with the password redacted.
+func (options CompleteConnectionOptions) GoString() string {
+	return redactedGoString(options)
+}
+
+// String returns the fields of the credential, with the password redacted.
+func (authentication ConnectionAuthentication) String() string {
+	return redactedString(authentication)
+}
+
+// GoString returns the fields of the credential, with the password redacted.
+func (authentication ConnectionAuthentication) GoString() string {
+	return redactedGoString(authentication)
+}
+
+// String returns the fields of the user, with the password redacted.
+func (user User) String() string {
+	return redactedString(user)
+}
+
+// GoString returns the fields of the user, with the password redacted.
+func (user User) GoString() string {
+	return redactedGoString(user)
+}
+
+// String returns the fields of the user, with the password redacted.
+func (user UserDatabaseUser) String() string {
+	return redactedString(user)
+}
+
+// GoString returns the fields of the user, with the password redacted.
+func (user UserDatabaseUser) GoString() string {
+	return redactedGoString(user)
+}
+
+// String returns the fields of the user, with the password redacted.
+func (user UserOpsManagerUser) String() string {
+	return redactedString(user)
+}
+
+// GoString returns the fields of the user, with the password redacted.
+func (user UserOpsManagerUser) GoString() string {
+	return redactedGoString(user)
+}
+
+// String returns the fields of the user, with the password redacted.
+func (user UserRedisDatabaseUser) String() string {
+	return redactedString(user)
+}
+
+// GoString returns the fields of the user, with the password redacted.
+func (user UserRedisDatabaseUser) GoString() string {
+	return redactedGoString(user)
+}
+
+// String returns the fields of the update, with the password redacted.
+func (update UserUpdate) String() string {
+	return redactedString(update)
+}
+
+// GoString returns the fields of the update, with the password redacted.
+func (update UserUpdate) GoString() string {
+	return redactedGoString(update)
+}
+
+// String returns the fields of the setting, with the password redacted.
+func (setting UserUpdatePasswordSetting) String() string {
+	return redactedString(setting)
+}
+
+// GoString returns the fields of the setting, with the password redacted.
+func (setting UserUpdatePasswordSetting) GoString() string {
+	return redactedGoString(setting)
+}
+
+// redactingLogger is a core.Logger that redacts secrets from debug messages, which include the request and response
+// bodies.
+type redactingLogger struct {
+	core.Logger
+}
+
+// NewRedactingLogger returns a core.Logger that redacts the debug messages of logger with RedactSecrets before
+// logging them. The core already redacts Authorization headers and "password" fields; this logger also masks the
+// passwords of the composed connection strings. Install it with core.SetLogger, for example:
+//
+//	core.SetLogger(clouddatabasesv5.NewRedactingLogger(core.GetLogger()))
+func NewRedactingLogger(logger core.Logger) core.Logger {
+	if _, ok := logger.(*redactingLogger); ok {
+		return logger
+	}
+	return &redactingLogger{Logger: logger}
+}
+
+// Log redacts debug messages.
+func (logger *redactingLogger) Log(level core.LogLevel, format string, inserts ...interface{}) {
+	if level == core.LevelDebug {
+		format, inserts = redactMessage(format, inserts)
+	}
+	logger.Logger.Log(level, format, inserts...)
+}
+
+// Debug redacts the message.
+func (logger *redactingLogger) Debug(format string, inserts ...interface{}) {
+	format, inserts = redactMessage(format, inserts)
+	logger.Logger.Debug(format, inserts...)
+}
+
+func redactMessage(format string, inserts []interface{}) (string, []interface{}) {
+	redactedInserts := make([]interface{}, len(inserts))
+	for i, insert := range inserts {
+		if s, ok := insert.(string); ok {
+			insert = RedactSecrets(s)
+		}
+		redactedInserts[i] = insert
+	}
+	return format, redactedInserts
+}
@@ -0,0 +1,72 @@
+//go:build go1.21
+
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import "log/slog"
+
+// redactedLogValue returns the fields of the model that are set as a group, with secrets redacted.
+func redactedLogValue(model interface{}) slog.Value {
+	var attrs []slog.Attr
+	for _, field := range redactedFields(model) {
+		if !field.isNil {
+			attrs = append(attrs, slog.Any(field.name, field.value))
+		}
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue returns the fields of the options, with the password redacted.
+func (options CompleteConnectionOptions) LogValue() slog.Value {
+	return redactedLogValue(options)
+}
+
+// LogValue returns the fields of the credential, with the password redacted.
+func (authentication ConnectionAuthentication) LogValue() slog.Value {
+	return redactedLogValue(authentication)
+}
+
+// LogValue returns the fields of the user, with the password redacted.
+func (user User) LogValue() slog.Value {
+	return redactedLogValue(user)
+}
+
+// LogValue returns the fields of the user, with the password redacted.
+func (user UserDatabaseUser) LogValue() slog.Value {
+	return redactedLogValue(user)
+}
+
+// LogValue returns the fields of the user, with the password redacted.
+func (user UserOpsManagerUser) LogValue() slog.Value {
+	return redactedLogValue(user)
+}
+
+// LogValue returns the fields of the user, with the password redacted.
+func (user UserRedisDatabaseUser) LogValue() slog.Value {
+	return redactedLogValue(user)
+}
+
+// LogValue returns the fields of the update, with the password redacted.
+func (update UserUpdate) LogValue() slog.Value {
+	return redactedLogValue(update)
+}
+
+// LogValue returns the fields of the setting, with the password redacted.
+func (setting UserUpdatePasswordSetting) LogValue() slog.Value {
+	return redactedLogValue(setting)
+}
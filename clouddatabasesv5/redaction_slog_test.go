@@ -0,0 +1,42 @@
+//go:build go1.21
+
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"bytes"
+	"log/slog"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Secret redaction in slog`, func() {
+	It(`Log models with their passwords redacted`, func() {
+		output := &bytes.Buffer{}
+		logger := slog.New(slog.NewTextHandler(output, nil))
+		logger.Info("creating user",
+			"user", &clouddatabasesv5.UserDatabaseUser{Username: core.StringPtr("app"), Password: core.StringPtr("s3cret-Passw0rd")},
+			"authentication", clouddatabasesv5.ConnectionAuthentication{Username: core.StringPtr("admin"), Password: core.StringPtr("s3cret-Passw0rd")},
+		)
+		Expect(output.String()).To(ContainSubstring("user.Username=app user.Password=[redacted] authentication.Username=admin authentication.Password=[redacted]"))
+		Expect(output.String()).ToNot(ContainSubstring("s3cret"))
+	})
+})
@@ -0,0 +1,375 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ErrInvalidRedisRole is wrapped by every error returned for a malformed Redis ACL role; use errors.Is to detect it.
+var ErrInvalidRedisRole = errors.New("invalid Redis role")
+
+// Constants associated with the Redis ACL command categories.
+// The service documents read, write, admin and all for database users; the other categories are those of Redis.
+const (
+	RedisCategoryAdmin       = "admin"
+	RedisCategoryAll         = "all"
+	RedisCategoryBitmap      = "bitmap"
+	RedisCategoryBlocking    = "blocking"
+	RedisCategoryConnection  = "connection"
+	RedisCategoryDangerous   = "dangerous"
+	RedisCategoryFast        = "fast"
+	RedisCategoryGeo         = "geo"
+	RedisCategoryHash        = "hash"
+	RedisCategoryHyperloglog = "hyperloglog"
+	RedisCategoryKeyspace    = "keyspace"
+	RedisCategoryList        = "list"
+	RedisCategoryPubsub      = "pubsub"
+	RedisCategoryRead        = "read"
+	RedisCategoryScripting   = "scripting"
+	RedisCategorySet         = "set"
+	RedisCategorySlow        = "slow"
+	RedisCategorySortedset   = "sortedset"
+	RedisCategoryStream      = "stream"
+	RedisCategoryString      = "string"
+	RedisCategoryTransaction = "transaction"
+	RedisCategoryWrite       = "write"
+)
+
+var redisCategories = []string{
+	RedisCategoryAdmin, RedisCategoryAll, RedisCategoryBitmap, RedisCategoryBlocking, RedisCategoryConnection,
+	RedisCategoryDangerous, RedisCategoryFast, RedisCategoryGeo, RedisCategoryHash, RedisCategoryHyperloglog,
+	RedisCategoryKeyspace, RedisCategoryList, RedisCategoryPubsub, RedisCategoryRead, RedisCategoryScripting,
+	RedisCategorySet, RedisCategorySlow, RedisCategorySortedset, RedisCategoryStream, RedisCategoryString,
+	RedisCategoryTransaction, RedisCategoryWrite,
+}
+
+// Constants associated with the RedisKeyPattern.Permission property.
+const (
+	RedisKeyPermissionReadWrite = ""
+	RedisKeyPermissionRead      = "R"
+	RedisKeyPermissionWrite     = "W"
+)
+
+// RedisRole : A Redis ACL role, as set in UserRedisDatabaseUser.Role and UserUpdateRedisRoleSetting.Role.
+// Build it with the Allow and Deny methods, or parse an existing role with ParseRedisRole; String returns the
+// canonical role.
+type RedisRole struct {
+	// The command rules, applied in order: a later rule overrides an earlier one.
+	Commands []RedisCommandRule
+
+	// The key patterns the role can access.
+	Keys []RedisKeyPattern
+
+	// The Pub/Sub channel patterns the role can access.
+	Channels []string
+}
+
+// RedisCommandRule : A rule that allows or denies a command category or a command.
+type RedisCommandRule struct {
+	// Whether the rule allows ("+") or denies ("-") the commands.
+	Allow bool
+
+	// A command category, such as RedisCategoryRead. Either Category or Command is set.
+	Category string
+
+	// A command, such as "get", or a subcommand, such as "config|get".
+	Command string
+}
+
+// RedisKeyPattern : A key pattern, in Redis glob syntax.
+type RedisKeyPattern struct {
+	// The pattern, for example "cache:*".
+	Pattern string
+
+	// RedisKeyPermissionReadWrite, RedisKeyPermissionRead or RedisKeyPermissionWrite.
+	Permission string
+}
+
+// NewRedisRole returns an empty role, which allows no command, key or channel.
+func NewRedisRole() *RedisRole {
+	return &RedisRole{}
+}
+
+// AllowCategories adds "+@category" rules.
+func (role *RedisRole) AllowCategories(categories ...string) *RedisRole {
+	for _, category := range categories {
+		role.Commands = append(role.Commands, RedisCommandRule{Allow: true, Category: category})
+	}
+	return role
+}
+
+// DenyCategories adds "-@category" rules.
+func (role *RedisRole) DenyCategories(categories ...string) *RedisRole {
+	for _, category := range categories {
+		role.Commands = append(role.Commands, RedisCommandRule{Allow: false, Category: category})
+	}
+	return role
+}
+
+// AllowCommands adds "+command" rules.
+func (role *RedisRole) AllowCommands(commands ...string) *RedisRole {
+	for _, command := range commands {
+		role.Commands = append(role.Commands, RedisCommandRule{Allow: true, Command: command})
+	}
+	return role
+}
+
+// DenyCommands adds "-command" rules.
+func (role *RedisRole) DenyCommands(commands ...string) *RedisRole {
+	for _, command := range commands {
+		role.Commands = append(role.Commands, RedisCommandRule{Allow: false, Command: command})
+	}
+	return role
+}
+
+// AllowKeys adds "~pattern" rules, for read and write access.
+func (role *RedisRole) AllowKeys(patterns ...string) *RedisRole {
+	return role.allowKeys(RedisKeyPermissionReadWrite, patterns)
+}
+
+// AllowReadKeys adds "%R~pattern" rules, for read access.
+func (role *RedisRole) AllowReadKeys(patterns ...string) *RedisRole {
+	return role.allowKeys(RedisKeyPermissionRead, patterns)
+}
+
+// AllowWriteKeys adds "%W~pattern" rules, for write access.
+func (role *RedisRole) AllowWriteKeys(patterns ...string) *RedisRole {
+	return role.allowKeys(RedisKeyPermissionWrite, patterns)
+}
+
+func (role *RedisRole) allowKeys(permission string, patterns []string) *RedisRole {
+	for _, pattern := range patterns {
+		role.Keys = append(role.Keys, RedisKeyPattern{Pattern: pattern, Permission: permission})
+	}
+	return role
+}
+
+// AllowChannels adds "&pattern" rules.
+func (role *RedisRole) AllowChannels(patterns ...string) *RedisRole {
+	role.Channels = append(role.Channels, patterns...)
+	return role
+}
+
+// String returns the canonical role: the command rules in order, then the key patterns, then the channel patterns,
+// separated by spaces. Categories and commands are lowercase, and repeated patterns are dropped.
+func (role *RedisRole) String() string {
+	var rules []string
+	for _, rule := range role.Commands {
+		rules = append(rules, rule.String())
+	}
+	seen := map[string]bool{}
+	for _, key := range role.Keys {
+		rule := key.String()
+		if !seen[rule] {
+			seen[rule] = true
+			rules = append(rules, rule)
+		}
+	}
+	for _, channel := range role.Channels {
+		rule := "&" + channel
+		if !seen[rule] {
+			seen[rule] = true
+			rules = append(rules, rule)
+		}
+	}
+	return strings.Join(rules, " ")
+}
+
+// String returns the rule in Redis ACL syntax.
+func (rule RedisCommandRule) String() string {
+	sign := "-"
+	if rule.Allow {
+		sign = "+"
+	}
+	if rule.Category != "" {
+		return sign + "@" + strings.ToLower(rule.Category)
+	}
+	return sign + strings.ToLower(rule.Command)
+}
+
+// String returns the pattern in Redis ACL syntax.
+func (key RedisKeyPattern) String() string {
+	if key.Permission == RedisKeyPermissionReadWrite {
+		return "~" + key.Pattern
+	}
+	return "%" + key.Permission + "~" + key.Pattern
+}
+
+// Validate returns an error wrapping ErrInvalidRedisRole for an empty role, an unknown category, a malformed command,
+// or an empty or malformed pattern.
+func (role *RedisRole) Validate() error {
+	if len(role.Commands) == 0 && len(role.Keys) == 0 && len(role.Channels) == 0 {
+		return invalidRedisRole("", "the role is empty")
+	}
+	for _, rule := range role.Commands {
+		switch {
+		case rule.Category != "" && rule.Command != "":
+			return invalidRedisRole(rule.String(), "a rule has either a category or a command")
+		case rule.Category != "":
+			if !containsString(redisCategories, strings.ToLower(rule.Category)) {
+				return invalidRedisRole(rule.String(), "unknown command category")
+			}
+		case !isRedisCommand(rule.Command):
+			return invalidRedisRole(rule.String(), "malformed command")
+		}
+	}
+	for _, key := range role.Keys {
+		if key.Permission != RedisKeyPermissionReadWrite && key.Permission != RedisKeyPermissionRead && key.Permission != RedisKeyPermissionWrite {
+			return invalidRedisRole(key.String(), "unknown key permission")
+		}
+		if !isRedisPattern(key.Pattern) {
+			return invalidRedisRole(key.String(), "empty or malformed key pattern")
+		}
+	}
+	for _, channel := range role.Channels {
+		if !isRedisPattern(channel) {
+			return invalidRedisRole("&"+channel, "empty or malformed channel pattern")
+		}
+	}
+	return nil
+}
+
+// ParseRedisRole parses a role in Redis ACL syntax, such as "+@all -@dangerous ~*", and validates it. Besides the
+// "+@category", "-@category", "+command", "-command", "~pattern", "%R~pattern", "%W~pattern", "%RW~pattern" and
+// "&pattern" rules, it accepts the allcommands, nocommands, allkeys, allchannels, resetkeys and resetchannels
+// shorthands. Rules about the user itself, such as "on" or ">password", are an error.
+func ParseRedisRole(role string) (*RedisRole, error) {
+	parsed := NewRedisRole()
+	for _, rule := range strings.Fields(role) {
+		switch lower := strings.ToLower(rule); {
+		case lower == "allcommands":
+			parsed.AllowCategories(RedisCategoryAll)
+		case lower == "nocommands":
+			parsed.DenyCategories(RedisCategoryAll)
+		case lower == "allkeys":
+			parsed.AllowKeys("*")
+		case lower == "resetkeys":
+			parsed.Keys = nil
+		case lower == "allchannels":
+			parsed.AllowChannels("*")
+		case lower == "resetchannels":
+			parsed.Channels = nil
+		case strings.HasPrefix(rule, "+@"):
+			parsed.AllowCategories(rule[2:])
+		case strings.HasPrefix(rule, "-@"):
+			parsed.DenyCategories(rule[2:])
+		case strings.HasPrefix(rule, "+"):
+			parsed.AllowCommands(rule[1:])
+		case strings.HasPrefix(rule, "-"):
+			parsed.DenyCommands(rule[1:])
+		case strings.HasPrefix(rule, "~"):
+			parsed.AllowKeys(rule[1:])
+		case strings.HasPrefix(rule, "%"):
+			permission, pattern, ok := strings.Cut(rule[1:], "~")
+			switch strings.ToUpper(permission) {
+			case "R":
+				permission = RedisKeyPermissionRead
+			case "W":
+				permission = RedisKeyPermissionWrite
+			case "RW", "WR":
+				permission = RedisKeyPermissionReadWrite
+			default:
+				ok = false
+			}
+			if !ok {
+				return nil, invalidRedisRole(rule, "malformed key permission")
+			}
+			parsed.allowKeys(permission, []string{pattern})
+		case strings.HasPrefix(rule, "&"):
+			parsed.AllowChannels(rule[1:])
+		default:
+			return nil, invalidRedisRole(rule, "unsupported rule")
+		}
+	}
+	err := parsed.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+// ValidateRedisRole parses the role and returns the error of ParseRedisRole, if any.
+func ValidateRedisRole(role string) error {
+	_, err := ParseRedisRole(role)
+	return err
+}
+
+// CanonicalRedisRole returns the canonical form of the role. See RedisRole.String.
+func CanonicalRedisRole(role string) (string, error) {
+	parsed, err := ParseRedisRole(role)
+	if err != nil {
+		return "", err
+	}
+	return parsed.String(), nil
+}
+
+// validateUserRole validates the role of a Redis user or of a Redis role update, if it has one. The roles of
+// ops_manager users are not Redis roles and are not validated.
+func validateUserRole(userType string, user interface{}) error {
+	var role *string
+	switch user := user.(type) {
+	case *UserRedisDatabaseUser:
+		role = user.Role
+	case *UserUpdateRedisRoleSetting:
+		role = user.Role
+	case *User:
+		role = user.Role
+	case *UserUpdate:
+		role = user.Role
+	}
+	if role == nil || userType == "ops_manager" {
+		return nil
+	}
+	return ValidateRedisRole(*role)
+}
+
+func isRedisCommand(command string) bool {
+	name, subcommand, hasSubcommand := strings.Cut(command, "|")
+	return isRedisName(name) && (!hasSubcommand || isRedisName(subcommand))
+}
+
+func isRedisName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range strings.ToLower(name) {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '_' && r != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+func isRedisPattern(pattern string) bool {
+	return pattern != "" && !strings.ContainsAny(pattern, " \t\r\n")
+}
+
+func invalidRedisRole(rule string, reason string) error {
+	var err error
+	if rule == "" {
+		err = fmt.Errorf("%w: %s", ErrInvalidRedisRole, reason)
+	} else {
+		err = fmt.Errorf("%w: '%s': %s", ErrInvalidRedisRole, rule, reason)
+	}
+	return core.SDKErrorf(err, "", "invalid-redis-role", common.GetComponentInfo())
+}
@@ -0,0 +1,138 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Redis roles`, func() {
+	It(`Build roles`, func() {
+		role := clouddatabasesv5.NewRedisRole().
+			AllowCategories(clouddatabasesv5.RedisCategoryAll).
+			DenyCategories(clouddatabasesv5.RedisCategoryDangerous).
+			AllowCommands("CONFIG|GET").
+			DenyCommands("flushall").
+			AllowKeys("cache:*", "cache:*").
+			AllowReadKeys("shared:*").
+			AllowWriteKeys("queue:*").
+			AllowChannels("events:*")
+		Expect(role.Validate()).To(BeNil())
+		Expect(role.String()).To(Equal("+@all -@dangerous +config|get -flushall ~cache:* %R~shared:* %W~queue:* &events:*"))
+
+		parsed, err := clouddatabasesv5.ParseRedisRole(role.String())
+		Expect(err).To(BeNil())
+		Expect(parsed.String()).To(Equal(role.String()))
+	})
+	It(`Parse roles into their canonical form`, func() {
+		roles := map[string]string{
+			"-@all +@read":                    "-@all +@read",
+			"  +@ALL   -@Dangerous  ~* ":      "+@all -@dangerous ~*",
+			"allcommands allkeys allchannels": "+@all ~* &*",
+			"nocommands ~a resetkeys ~b":      "-@all ~b",
+			"+@read &a resetchannels":         "+@read",
+			"+@read %RW~a %rw~b %r~c %w~d":    "+@read ~a ~b %R~c %W~d",
+			"+get +client|setname":            "+get +client|setname",
+		}
+		for role, canonical := range roles {
+			Expect(clouddatabasesv5.CanonicalRedisRole(role)).To(Equal(canonical), role)
+		}
+	})
+	It(`Reject malformed roles`, func() {
+		roles := []string{
+			"",
+			"   ",
+			"+@nope",
+			"+@",
+			"+",
+			"-get|",
+			"+ge*t",
+			"~",
+			"&",
+			"%X~a",
+			"%R",
+			"on",
+			">password",
+			"nopass",
+			"reset",
+		}
+		for _, role := range roles {
+			err := clouddatabasesv5.ValidateRedisRole(role)
+			Expect(errors.Is(err, clouddatabasesv5.ErrInvalidRedisRole)).To(BeTrue(), role)
+		}
+		err := clouddatabasesv5.ValidateRedisRole("+@all +@nope")
+		Expect(err.Error()).To(ContainSubstring("'+@nope': unknown command category"))
+
+		Expect((&clouddatabasesv5.RedisRole{Commands: []clouddatabasesv5.RedisCommandRule{{Allow: true, Category: "read", Command: "get"}}}).Validate()).ToNot(BeNil())
+		Expect((&clouddatabasesv5.RedisRole{Keys: []clouddatabasesv5.RedisKeyPattern{{Pattern: "a", Permission: "X"}}}).Validate()).ToNot(BeNil())
+	})
+	It(`Validate roles before creating or updating users`, func() {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests++
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(202)
+			fmt.Fprint(res, `{"task": {"id": "task-id", "status": "queued"}}`)
+		}))
+		defer server.Close()
+		cloudDatabasesService, err := clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		const deploymentID = "crn:v1:bluemix:public:databases-for-redis:us-south:a/abc123:d1a2b3c4-0000-4000-8000-000000000001::"
+
+		user := &clouddatabasesv5.UserRedisDatabaseUser{Username: core.StringPtr("cache"), Password: core.StringPtr("cache-password-123"), Role: core.StringPtr("+@all -@nope")}
+		createDatabaseUserOptions := cloudDatabasesService.NewCreateDatabaseUserOptions(deploymentID, "database").SetUser(user)
+		_, _, err = cloudDatabasesService.CreateDatabaseUser(createDatabaseUserOptions)
+		Expect(errors.Is(err, clouddatabasesv5.ErrInvalidRedisRole)).To(BeTrue())
+
+		updateUserOptions := cloudDatabasesService.NewUpdateUserOptions(deploymentID, "database", "cache").
+			SetUser(&clouddatabasesv5.UserUpdateRedisRoleSetting{Role: core.StringPtr("+@read on")})
+		_, _, err = cloudDatabasesService.UpdateUser(updateUserOptions)
+		Expect(errors.Is(err, clouddatabasesv5.ErrInvalidRedisRole)).To(BeTrue())
+		Expect(requests).To(Equal(0))
+
+		createDatabaseUserOptions.SetUser(&clouddatabasesv5.User{Username: core.StringPtr("cache"), Password: core.StringPtr("cache-password-123"), Role: core.StringPtr("+@all -@nope")})
+		_, _, err = cloudDatabasesService.CreateDatabaseUser(createDatabaseUserOptions)
+		Expect(errors.Is(err, clouddatabasesv5.ErrInvalidRedisRole)).To(BeTrue())
+
+		updateUserOptions.SetUser(&clouddatabasesv5.UserUpdate{Role: core.StringPtr("+@read on")})
+		_, _, err = cloudDatabasesService.UpdateUser(updateUserOptions)
+		Expect(errors.Is(err, clouddatabasesv5.ErrInvalidRedisRole)).To(BeTrue())
+		Expect(requests).To(Equal(0))
+
+		createDatabaseUserOptions.SetUser(user)
+		user.Role = core.StringPtr(clouddatabasesv5.NewRedisRole().AllowCategories(clouddatabasesv5.RedisCategoryRead).AllowKeys("cache:*").String())
+		_, _, err = cloudDatabasesService.CreateDatabaseUser(createDatabaseUserOptions)
+		Expect(err).To(BeNil())
+		Expect(requests).To(Equal(1))
+
+		opsManagerUser := &clouddatabasesv5.User{Username: core.StringPtr("ops"), Password: core.StringPtr("v3ry-1-secUre-pAssword-2"), Role: core.StringPtr("group_data_access_admin")}
+		_, _, err = cloudDatabasesService.CreateDatabaseUser(cloudDatabasesService.NewCreateDatabaseUserOptions(deploymentID, "ops_manager").SetUser(opsManagerUser))
+		Expect(err).To(BeNil())
+		Expect(requests).To(Equal(2))
+	})
+})
@@ -0,0 +1,126 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PrivateParameterizedServiceURL is the parameterized URL of the private endpoints, which are only reachable from
+// the IBM Cloud private network.
+const PrivateParameterizedServiceURL = "https://api.{region}.private.databases.cloud.ibm.com/v5/{platform}"
+
+// supportedRegions lists the regions in which IBM Cloud Databases serves the API.
+var supportedRegions = []string{
+	"au-syd",
+	"br-sao",
+	"ca-tor",
+	"eu-de",
+	"eu-es",
+	"eu-gb",
+	"jp-osa",
+	"jp-tok",
+	"us-east",
+	"us-south",
+}
+
+// Property names read from external configuration by NewCloudDatabasesV5UsingExternalConfig, for example
+// CLOUD_DATABASES_REGION in the environment.
+const (
+	propRegion          = "REGION"
+	propPlatform        = "PLATFORM"
+	propPrivateEndpoint = "PRIVATE_ENDPOINT"
+)
+
+// SupportedRegions returns the regions in which IBM Cloud Databases serves the API.
+func SupportedRegions() []string {
+	return append([]string(nil), supportedRegions...)
+}
+
+// IsSupportedRegion returns true if IBM Cloud Databases serves the API in the specified region.
+func IsSupportedRegion(region string) bool {
+	for _, supported := range supportedRegions {
+		if region == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPrivateServiceURLForRegion returns the private endpoint service URL to be used for the specified region
+func GetPrivateServiceURLForRegion(region string) (string, error) {
+	if !IsSupportedRegion(region) {
+		return "", core.SDKErrorf(nil, fmt.Sprintf("service does not support region '%s'", region), "unsupported-region", common.GetComponentInfo())
+	}
+	return ConstructPrivateServiceURL(map[string]string{"region": region})
+}
+
+// ConstructPrivateServiceURL constructs a private endpoint service URL from the parameterized URL.
+func ConstructPrivateServiceURL(providedUrlVariables map[string]string) (string, error) {
+	return core.ConstructServiceURL(PrivateParameterizedServiceURL, defaultUrlVariables, providedUrlVariables)
+}
+
+// hasEndpointOptions returns true if any of the options that select a regional endpoint are set.
+func hasEndpointOptions(options *CloudDatabasesV5Options) bool {
+	return options.Region != "" || options.Platform != "" || options.PrivateEndpoint
+}
+
+// serviceURLForOptions builds the service URL selected by the Region, Platform and PrivateEndpoint options.
+func serviceURLForOptions(options *CloudDatabasesV5Options) (string, error) {
+	urlVariables := map[string]string{}
+	if options.Region != "" {
+		if !IsSupportedRegion(options.Region) {
+			return "", core.SDKErrorf(nil, fmt.Sprintf("service does not support region '%s'", options.Region), "unsupported-region", common.GetComponentInfo())
+		}
+		urlVariables["region"] = options.Region
+	}
+	if options.Platform != "" {
+		urlVariables["platform"] = options.Platform
+	}
+
+	parameterizedURL := ParameterizedServiceURL
+	if options.PrivateEndpoint {
+		parameterizedURL = PrivateParameterizedServiceURL
+	}
+	serviceURL, err := core.ConstructServiceURL(parameterizedURL, defaultUrlVariables, urlVariables)
+	if err != nil {
+		return "", core.SDKErrorf(err, "", "construct-url-error", common.GetComponentInfo())
+	}
+	return serviceURL, nil
+}
+
+// loadEndpointOptions fills in the Region, Platform and PrivateEndpoint options from external configuration.
+func loadEndpointOptions(options *CloudDatabasesV5Options) error {
+	props, err := core.GetServiceProperties(options.ServiceName)
+	if err != nil {
+		return core.SDKErrorf(err, "", "get-props-error", common.GetComponentInfo())
+	}
+
+	options.Region = props[propRegion]
+	options.Platform = props[propPlatform]
+	if value, ok := props[propPrivateEndpoint]; ok && value != "" {
+		options.PrivateEndpoint, err = strconv.ParseBool(value)
+		if err != nil {
+			return core.SDKErrorf(err, fmt.Sprintf("invalid %s value '%s'", propPrivateEndpoint, value), "invalid-private-endpoint", common.GetComponentInfo())
+		}
+	}
+	return nil
+}
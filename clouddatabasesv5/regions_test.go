@@ -0,0 +1,89 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Regional endpoints`, func() {
+	Describe(`GetServiceURLForRegion and GetPrivateServiceURLForRegion`, func() {
+		It(`Return the URL of every supported region`, func() {
+			for _, region := range clouddatabasesv5.SupportedRegions() {
+				url, err := clouddatabasesv5.GetServiceURLForRegion(region)
+				Expect(err).To(BeNil())
+				Expect(url).To(Equal("https://api." + region + ".databases.cloud.ibm.com/v5/ibm"))
+
+				url, err = clouddatabasesv5.GetPrivateServiceURLForRegion(region)
+				Expect(err).To(BeNil())
+				Expect(url).To(Equal("https://api." + region + ".private.databases.cloud.ibm.com/v5/ibm"))
+			}
+		})
+		It(`Return an error for an unknown region`, func() {
+			url, err := clouddatabasesv5.GetPrivateServiceURLForRegion("mars-north")
+			Expect(url).To(BeEmpty())
+			Expect(err).ToNot(BeNil())
+			Expect(clouddatabasesv5.IsSupportedRegion("mars-north")).To(BeFalse())
+		})
+	})
+	Describe(`Service constructor with endpoint options`, func() {
+		It(`Use the region, platform and private endpoint options`, func() {
+			cloudDatabasesService, serviceErr := clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+				Region:        "eu-de",
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(cloudDatabasesService.GetServiceURL()).To(Equal("https://api.eu-de.databases.cloud.ibm.com/v5/ibm"))
+
+			cloudDatabasesService, serviceErr = clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+				Authenticator:   &core.NoAuthAuthenticator{},
+				Region:          "jp-tok",
+				Platform:        "ibm",
+				PrivateEndpoint: true,
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(cloudDatabasesService.GetServiceURL()).To(Equal("https://api.jp-tok.private.databases.cloud.ibm.com/v5/ibm"))
+
+			cloudDatabasesService, serviceErr = clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+				Authenticator:   &core.NoAuthAuthenticator{},
+				PrivateEndpoint: true,
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(cloudDatabasesService.GetServiceURL()).To(Equal("https://api.us-south.private.databases.cloud.ibm.com/v5/ibm"))
+		})
+		It(`Prefer an explicit URL over the region`, func() {
+			cloudDatabasesService, serviceErr := clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+				URL:           "https://clouddatabasesv5/api",
+				Region:        "eu-de",
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(cloudDatabasesService.GetServiceURL()).To(Equal("https://clouddatabasesv5/api"))
+		})
+		It(`Instantiate service client with error: Unsupported region`, func() {
+			cloudDatabasesService, serviceErr := clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+				Region:        "mars-north",
+			})
+			Expect(cloudDatabasesService).To(BeNil())
+			Expect(serviceErr).ToNot(BeNil())
+		})
+	})
+})
@@ -0,0 +1,260 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package rotation rotates the credentials of a Cloud Databases user without downtime, with two users.
+//
+// Rotate creates a new user, hands its credentials to the consumers through a caller-supplied Publish hook, waits for
+// a grace period during which the consumers switch over, and then deletes the old user. Each step waits for its task.
+// If the new user cannot be created or published, the rotation is rolled back and the old user is left untouched:
+//
+//	result, err := rotation.Rotate(ctx, cloudDatabasesService, &rotation.Options{
+//		DeploymentID: deploymentID,
+//		OldUsername:  "app_a",
+//		NewUser:      &clouddatabasesv5.UserDatabaseUser{Username: core.StringPtr("app_b"), Password: core.StringPtr(password)},
+//		Publish: func(ctx context.Context, credentials rotation.Credentials) error {
+//			return secrets.Put(ctx, "db", credentials.Username, credentials.Password)
+//		},
+//		GracePeriod: 10 * time.Minute,
+//	})
+package rotation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultUserType is the type of the rotated users when Options.UserType is not set.
+const DefaultUserType = "database"
+
+// DefaultRollbackTimeout is how long a rollback may take when Options.RollbackTimeout is not set.
+const DefaultRollbackTimeout = 10 * time.Minute
+
+// Stage : A step of the rotation.
+type Stage string
+
+// Constants associated with the Stage type.
+const (
+	// Creating the new user.
+	StageCreate Stage = "create"
+
+	// Handing the credentials of the new user to the consumers.
+	StagePublish Stage = "publish"
+
+	// Waiting for the consumers to switch to the new user.
+	StageGracePeriod Stage = "grace_period"
+
+	// Deleting the old user.
+	StageDelete Stage = "delete"
+
+	// The rotation completed.
+	StageDone Stage = "done"
+)
+
+// Credentials : The credentials of the new user, as handed to the Publish hook.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// Options : The Rotate options.
+type Options struct {
+	// The ID of the deployment.
+	DeploymentID string
+
+	// The type of the users. Defaults to DefaultUserType.
+	UserType string
+
+	// The user whose credentials are rotated. It is deleted once the new user is published and the grace period is
+	// over.
+	OldUsername string
+
+	// The user to create, for example a *clouddatabasesv5.UserDatabaseUser, with a username different from
+	// OldUsername and its password.
+	NewUser clouddatabasesv5.UserIntf
+
+	// Hands the credentials of the new user to every consumer, for example by writing them to a secret store.
+	// Required.
+	Publish func(ctx context.Context, credentials Credentials) error
+
+	// Restores the credentials of the old user for the consumers when Publish fails, in case it made part of the
+	// change. Optional. If Revert fails too, the new user is kept since consumers may be using it.
+	Revert func(ctx context.Context) error
+
+	// How long to wait between publishing the new credentials and deleting the old user.
+	GracePeriod time.Duration
+
+	// The options used to wait for each task. See clouddatabasesv5.CloudDatabasesV5.WaitForTask.
+	WaitForTaskOptions *clouddatabasesv5.WaitForTaskOptions
+
+	// How long the rollback, including Revert, may take. The rollback does not use the context passed to Rotate,
+	// which may have ended, but a context of its own with this timeout. Defaults to DefaultRollbackTimeout.
+	RollbackTimeout time.Duration
+}
+
+// Result : The outcome of Rotate.
+type Result struct {
+	// The stage the rotation reached: StageDone on success, otherwise the stage that failed.
+	Stage Stage
+
+	// The task that created the new user, if it was started.
+	CreateTask *clouddatabasesv5.Task
+
+	// The task that deleted the old user, or the new user on rollback, if it was started.
+	DeleteTask *clouddatabasesv5.Task
+
+	// Whether the new user was deleted after a failure. When a failure happens after the new credentials were
+	// published, nothing is rolled back: both users exist and the old user can be deleted later.
+	RolledBack bool
+}
+
+// Rotate runs the rotation described by the options. The result is returned along with the error, and tells which
+// stage failed and whether the rotation was rolled back.
+func Rotate(ctx context.Context, api clouddatabasesv5.CloudDatabasesAPI, options *Options) (result *Result, err error) {
+	credentials, err := options.validate()
+	if err != nil {
+		return
+	}
+	userType := options.UserType
+	if userType == "" {
+		userType = DefaultUserType
+	}
+	result = &Result{Stage: StageCreate}
+
+	createDatabaseUserOptions := (*clouddatabasesv5.CloudDatabasesV5)(nil).NewCreateDatabaseUserOptions(options.DeploymentID, userType)
+	createDatabaseUserOptions.SetUser(options.NewUser)
+	created, task, _, err := api.CreateDatabaseUserAndWait(ctx, createDatabaseUserOptions, options.WaitForTaskOptions)
+	result.CreateTask = task
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "create-user-error")
+		// The user may exist if its task failed or could not be waited for.
+		if created != nil {
+			rollbackCtx, cancel := options.rollbackContext()
+			defer cancel()
+			err = result.rollback(rollbackCtx, api, options, userType, credentials.Username, created.Task, err)
+		}
+		return
+	}
+
+	result.Stage = StagePublish
+	err = options.Publish(ctx, credentials)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "publish-error", common.GetComponentInfo())
+		rollbackCtx, cancel := options.rollbackContext()
+		defer cancel()
+		if options.Revert != nil {
+			if revertErr := options.Revert(rollbackCtx); revertErr != nil {
+				err = core.SDKErrorf(fmt.Errorf("%s; revert failed: %w", err.Error(), revertErr), "", "revert-error", common.GetComponentInfo())
+				return
+			}
+		}
+		err = result.rollback(rollbackCtx, api, options, userType, credentials.Username, nil, err)
+		return
+	}
+
+	result.Stage = StageGracePeriod
+	if options.GracePeriod > 0 {
+		timer := time.NewTimer(options.GracePeriod)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = core.SDKErrorf(ctx.Err(), "", "grace-period-error", common.GetComponentInfo())
+			return
+		case <-timer.C:
+		}
+	}
+
+	result.Stage = StageDelete
+	deleteDatabaseUserOptions := (*clouddatabasesv5.CloudDatabasesV5)(nil).NewDeleteDatabaseUserOptions(options.DeploymentID, userType, options.OldUsername)
+	_, result.DeleteTask, _, err = api.DeleteDatabaseUserAndWait(ctx, deleteDatabaseUserOptions, options.WaitForTaskOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "delete-user-error")
+		return
+	}
+	result.Stage = StageDone
+	return
+}
+
+// rollback deletes the new user after the failure cause, once createTask, if any, has ended. The user not existing is
+// not an error. It returns cause, or an error describing both failures if the user cannot be deleted.
+func (result *Result) rollback(ctx context.Context, api clouddatabasesv5.CloudDatabasesAPI, options *Options, userType string, username string, createTask *clouddatabasesv5.Task, cause error) error {
+	if createTask != nil && createTask.ID != nil {
+		// The task failing is what the rollback is for.
+		_, _ = api.WaitForTask(ctx, *createTask.ID, options.WaitForTaskOptions)
+	}
+	deleteDatabaseUserOptions := (*clouddatabasesv5.CloudDatabasesV5)(nil).NewDeleteDatabaseUserOptions(options.DeploymentID, userType, username)
+	_, task, _, err := api.DeleteDatabaseUserAndWait(ctx, deleteDatabaseUserOptions, options.WaitForTaskOptions)
+	result.DeleteTask = task
+	if err != nil && !errors.Is(err, clouddatabasesv5.ErrNotFound) {
+		err = fmt.Errorf("%s; rollback failed: %w", cause.Error(), err)
+		return core.SDKErrorf(err, "", "rollback-error", common.GetComponentInfo())
+	}
+	result.RolledBack = true
+	return cause
+}
+
+// rollbackContext returns the context of a rollback, detached from the context of Rotate.
+func (options *Options) rollbackContext() (context.Context, context.CancelFunc) {
+	timeout := options.RollbackTimeout
+	if timeout <= 0 {
+		timeout = DefaultRollbackTimeout
+	}
+	return context.WithTimeout(context.Background(), timeout)
+}
+
+// validate checks the options and returns the credentials of the new user.
+func (options *Options) validate() (credentials Credentials, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err == nil {
+		err = core.ValidateNotNil(options.NewUser, "NewUser cannot be nil")
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	buf, err := json.Marshal(options.NewUser)
+	if err == nil {
+		err = json.Unmarshal(buf, &credentials)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "new-user-error", common.GetComponentInfo())
+		return
+	}
+
+	switch {
+	case options.DeploymentID == "":
+		err = errors.New("DeploymentID is required")
+	case options.OldUsername == "":
+		err = errors.New("OldUsername is required")
+	case credentials.Username == "" || credentials.Password == "":
+		err = errors.New("NewUser must have a username and a password")
+	case credentials.Username == options.OldUsername:
+		err = fmt.Errorf("NewUser must have a username other than '%s'", options.OldUsername)
+	case options.Publish == nil:
+		err = errors.New("Publish is required")
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-options", common.GetComponentInfo())
+	}
+	return
+}
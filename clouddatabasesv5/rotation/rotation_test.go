@@ -0,0 +1,163 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rotation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5/fake"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const deploymentID = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/abc123:d1a2b3c4-0000-4000-8000-000000000001::"
+
+func newTestService(t *testing.T, options *fake.Options) (*fake.Server, *clouddatabasesv5.CloudDatabasesV5) {
+	server, service := fake.NewTestService(t, options, clouddatabasesv5.Deployment{ID: core.StringPtr(deploymentID), Type: core.StringPtr("postgresql")})
+
+	createDatabaseUserOptions := service.NewCreateDatabaseUserOptions(deploymentID, DefaultUserType)
+	createDatabaseUserOptions.SetUser(&clouddatabasesv5.UserDatabaseUser{Username: core.StringPtr("app_a"), Password: core.StringPtr("old-password-0123")})
+	_, _, _, err := service.CreateDatabaseUserAndWait(context.Background(), createDatabaseUserOptions, newOptions(nil).WaitForTaskOptions)
+	require.Nil(t, err)
+	return server, service
+}
+
+func newOptions(publish func(ctx context.Context, credentials Credentials) error) *Options {
+	return &Options{
+		DeploymentID:       deploymentID,
+		OldUsername:        "app_a",
+		NewUser:            &clouddatabasesv5.UserDatabaseUser{Username: core.StringPtr("app_b"), Password: core.StringPtr("new-password-0123")},
+		Publish:            publish,
+		GracePeriod:        time.Millisecond,
+		WaitForTaskOptions: (*clouddatabasesv5.CloudDatabasesV5)(nil).NewWaitForTaskOptions().SetPollInterval(time.Millisecond),
+	}
+}
+
+func TestRotate(t *testing.T) {
+	server, service := newTestService(t, nil)
+
+	var published []Credentials
+	result, err := Rotate(context.Background(), service, newOptions(func(ctx context.Context, credentials Credentials) error {
+		// The new user exists and the old one is still there when the credentials are published.
+		assert.Equal(t, []string{"app_a", "app_b"}, server.Users(deploymentID, DefaultUserType))
+		published = append(published, credentials)
+		return nil
+	}))
+	require.Nil(t, err)
+	assert.Equal(t, StageDone, result.Stage)
+	assert.Equal(t, clouddatabasesv5.TaskStatusCompletedConst, *result.CreateTask.Status)
+	assert.Equal(t, clouddatabasesv5.TaskStatusCompletedConst, *result.DeleteTask.Status)
+	assert.False(t, result.RolledBack)
+	assert.Equal(t, []Credentials{{Username: "app_b", Password: "new-password-0123"}}, published)
+	assert.Equal(t, []string{"app_b"}, server.Users(deploymentID, DefaultUserType))
+}
+
+func TestRollbackFailedPublish(t *testing.T) {
+	server, service := newTestService(t, nil)
+
+	unavailable := errors.New("secret store unavailable")
+	reverted := false
+	options := newOptions(func(ctx context.Context, credentials Credentials) error {
+		return unavailable
+	})
+	options.Revert = func(ctx context.Context) error {
+		reverted = true
+		return nil
+	}
+	result, err := Rotate(context.Background(), service, options)
+	assert.True(t, errors.Is(err, unavailable))
+	assert.Equal(t, StagePublish, result.Stage)
+	assert.True(t, result.RolledBack)
+	assert.True(t, reverted)
+	assert.Equal(t, []string{"app_a"}, server.Users(deploymentID, DefaultUserType))
+}
+
+func TestRollbackFailedCreate(t *testing.T) {
+	server, service := newTestService(t, nil)
+	server.FailNextTask(deploymentID)
+
+	published := false
+	result, err := Rotate(context.Background(), service, newOptions(func(ctx context.Context, credentials Credentials) error {
+		published = true
+		return nil
+	}))
+	assert.True(t, errors.Is(err, clouddatabasesv5.ErrTaskFailed))
+	assert.Equal(t, StageCreate, result.Stage)
+	assert.Equal(t, clouddatabasesv5.TaskStatusFailedConst, *result.CreateTask.Status)
+	assert.True(t, result.RolledBack)
+	assert.False(t, published)
+	assert.Equal(t, []string{"app_a"}, server.Users(deploymentID, DefaultUserType))
+}
+
+func TestRollbackCanceledCreate(t *testing.T) {
+	server, service := newTestService(t, &fake.Options{RunningDuration: 20 * time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	options := newOptions(func(ctx context.Context, credentials Credentials) error {
+		return nil
+	})
+	// Cancel the context while the task creating the new user is running.
+	options.WaitForTaskOptions.OnProgress = func(task *clouddatabasesv5.Task) {
+		cancel()
+	}
+	result, err := Rotate(ctx, service, options)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, StageCreate, result.Stage)
+	assert.True(t, result.RolledBack)
+	require.NotNil(t, result.DeleteTask)
+	assert.Equal(t, clouddatabasesv5.TaskStatusCompletedConst, *result.DeleteTask.Status)
+	assert.Equal(t, []string{"app_a"}, server.Users(deploymentID, DefaultUserType))
+}
+
+func TestCanceledGracePeriod(t *testing.T) {
+	server, service := newTestService(t, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	options := newOptions(func(ctx context.Context, credentials Credentials) error {
+		cancel()
+		return nil
+	})
+	options.GracePeriod = time.Hour
+	result, err := Rotate(ctx, service, options)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, StageGracePeriod, result.Stage)
+	assert.False(t, result.RolledBack)
+	// Both users are kept: consumers may already use the new one.
+	assert.Equal(t, []string{"app_a", "app_b"}, server.Users(deploymentID, DefaultUserType))
+}
+
+func TestInvalidOptions(t *testing.T) {
+	_, service := newTestService(t, nil)
+	publish := func(ctx context.Context, credentials Credentials) error { return nil }
+
+	options := newOptions(publish)
+	options.NewUser = &clouddatabasesv5.UserDatabaseUser{Username: core.StringPtr("app_a"), Password: core.StringPtr("new-password-0123")}
+	_, err := Rotate(context.Background(), service, options)
+	assert.NotNil(t, err)
+
+	_, err = Rotate(context.Background(), service, newOptions(nil))
+	assert.NotNil(t, err)
+
+	_, err = Rotate(context.Background(), service, nil)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,917 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package stub provides a scriptable implementation of clouddatabasesv5.CloudDatabasesAPI that records every call,
+// for unit testing code that uses the Cloud Databases service without making HTTP requests.
+package stub
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Client : A recording stub of the Cloud Databases service.
+// Each operation can be scripted by setting the corresponding Func field; see Return and Fail for canned results.
+// An operation without a Func returns an empty result with a 200 response. For operations that produce a task,
+// that result holds a new queued task, and WaitForTask reports such tasks as completed.
+type Client struct {
+	ListDeployablesFunc                  func(ctx context.Context, listDeployablesOptions *clouddatabasesv5.ListDeployablesOptions) (*clouddatabasesv5.ListDeployablesResponse, *core.DetailedResponse, error)
+	ListRegionsFunc                      func(ctx context.Context, listRegionsOptions *clouddatabasesv5.ListRegionsOptions) (*clouddatabasesv5.ListRegionsResponse, *core.DetailedResponse, error)
+	GetDeploymentInfoFunc                func(ctx context.Context, getDeploymentInfoOptions *clouddatabasesv5.GetDeploymentInfoOptions) (*clouddatabasesv5.GetDeploymentInfoResponse, *core.DetailedResponse, error)
+	CreateDatabaseUserFunc               func(ctx context.Context, createDatabaseUserOptions *clouddatabasesv5.CreateDatabaseUserOptions) (*clouddatabasesv5.CreateDatabaseUserResponse, *core.DetailedResponse, error)
+	UpdateUserFunc                       func(ctx context.Context, updateUserOptions *clouddatabasesv5.UpdateUserOptions) (*clouddatabasesv5.UpdateUserResponse, *core.DetailedResponse, error)
+	DeleteDatabaseUserFunc               func(ctx context.Context, deleteDatabaseUserOptions *clouddatabasesv5.DeleteDatabaseUserOptions) (*clouddatabasesv5.DeleteDatabaseUserResponse, *core.DetailedResponse, error)
+	GetDatabaseConfigurationSchemaFunc   func(ctx context.Context, getDatabaseConfigurationSchemaOptions *clouddatabasesv5.GetDatabaseConfigurationSchemaOptions) (*clouddatabasesv5.GetDatabaseConfigurationSchemaResponse, *core.DetailedResponse, error)
+	UpdateDatabaseConfigurationFunc      func(ctx context.Context, updateDatabaseConfigurationOptions *clouddatabasesv5.UpdateDatabaseConfigurationOptions) (*clouddatabasesv5.UpdateDatabaseConfigurationResponse, *core.DetailedResponse, error)
+	ListRemotesFunc                      func(ctx context.Context, listRemotesOptions *clouddatabasesv5.ListRemotesOptions) (*clouddatabasesv5.ListRemotesResponse, *core.DetailedResponse, error)
+	ResyncReplicaFunc                    func(ctx context.Context, resyncReplicaOptions *clouddatabasesv5.ResyncReplicaOptions) (*clouddatabasesv5.ResyncReplicaResponse, *core.DetailedResponse, error)
+	PromoteReadOnlyReplicaFunc           func(ctx context.Context, promoteReadOnlyReplicaOptions *clouddatabasesv5.PromoteReadOnlyReplicaOptions) (*clouddatabasesv5.PromoteReadOnlyReplicaResponse, *core.DetailedResponse, error)
+	ListDeploymentTasksFunc              func(ctx context.Context, listDeploymentTasksOptions *clouddatabasesv5.ListDeploymentTasksOptions) (*clouddatabasesv5.Tasks, *core.DetailedResponse, error)
+	GetTaskFunc                          func(ctx context.Context, getTaskOptions *clouddatabasesv5.GetTaskOptions) (*clouddatabasesv5.GetTaskResponse, *core.DetailedResponse, error)
+	GetBackupInfoFunc                    func(ctx context.Context, getBackupInfoOptions *clouddatabasesv5.GetBackupInfoOptions) (*clouddatabasesv5.GetBackupInfoResponse, *core.DetailedResponse, error)
+	ListDeploymentBackupsFunc            func(ctx context.Context, listDeploymentBackupsOptions *clouddatabasesv5.ListDeploymentBackupsOptions) (*clouddatabasesv5.Backups, *core.DetailedResponse, error)
+	StartOndemandBackupFunc              func(ctx context.Context, startOndemandBackupOptions *clouddatabasesv5.StartOndemandBackupOptions) (*clouddatabasesv5.StartOndemandBackupResponse, *core.DetailedResponse, error)
+	GetPitrDataFunc                      func(ctx context.Context, getPitrDataOptions *clouddatabasesv5.GetPitrDataOptions) (*clouddatabasesv5.GetPitrDataResponse, *core.DetailedResponse, error)
+	GetConnectionFunc                    func(ctx context.Context, getConnectionOptions *clouddatabasesv5.GetConnectionOptions) (*clouddatabasesv5.GetConnectionResponse, *core.DetailedResponse, error)
+	CompleteConnectionFunc               func(ctx context.Context, completeConnectionOptions *clouddatabasesv5.CompleteConnectionOptions) (*clouddatabasesv5.CompleteConnectionResponse, *core.DetailedResponse, error)
+	ListDeploymentScalingGroupsFunc      func(ctx context.Context, listDeploymentScalingGroupsOptions *clouddatabasesv5.ListDeploymentScalingGroupsOptions) (*clouddatabasesv5.ListDeploymentScalingGroupsResponse, *core.DetailedResponse, error)
+	GetDefaultScalingGroupsFunc          func(ctx context.Context, getDefaultScalingGroupsOptions *clouddatabasesv5.GetDefaultScalingGroupsOptions) (*clouddatabasesv5.GetDefaultScalingGroupsResponse, *core.DetailedResponse, error)
+	SetDeploymentScalingGroupFunc        func(ctx context.Context, setDeploymentScalingGroupOptions *clouddatabasesv5.SetDeploymentScalingGroupOptions) (*clouddatabasesv5.SetDeploymentScalingGroupResponse, *core.DetailedResponse, error)
+	GetAutoscalingConditionsFunc         func(ctx context.Context, getAutoscalingConditionsOptions *clouddatabasesv5.GetAutoscalingConditionsOptions) (*clouddatabasesv5.AutoscalingGroup, *core.DetailedResponse, error)
+	SetAutoscalingConditionsFunc         func(ctx context.Context, setAutoscalingConditionsOptions *clouddatabasesv5.SetAutoscalingConditionsOptions) (*clouddatabasesv5.SetAutoscalingConditionsResponse, *core.DetailedResponse, error)
+	KillConnectionsFunc                  func(ctx context.Context, killConnectionsOptions *clouddatabasesv5.KillConnectionsOptions) (*clouddatabasesv5.KillConnectionsResponse, *core.DetailedResponse, error)
+	CreateLogicalReplicationSlotFunc     func(ctx context.Context, createLogicalReplicationSlotOptions *clouddatabasesv5.CreateLogicalReplicationSlotOptions) (*clouddatabasesv5.CreateLogicalReplicationSlotResponse, *core.DetailedResponse, error)
+	DeleteLogicalReplicationSlotFunc     func(ctx context.Context, deleteLogicalReplicationSlotOptions *clouddatabasesv5.DeleteLogicalReplicationSlotOptions) (*clouddatabasesv5.DeleteLogicalReplicationSlotResponse, *core.DetailedResponse, error)
+	GetAllowlistFunc                     func(ctx context.Context, getAllowlistOptions *clouddatabasesv5.GetAllowlistOptions) (*clouddatabasesv5.GetAllowlistResponse, *core.DetailedResponse, error)
+	SetAllowlistFunc                     func(ctx context.Context, setAllowlistOptions *clouddatabasesv5.SetAllowlistOptions) (*clouddatabasesv5.SetAllowlistResponse, *core.DetailedResponse, error)
+	AddAllowlistEntryFunc                func(ctx context.Context, addAllowlistEntryOptions *clouddatabasesv5.AddAllowlistEntryOptions) (*clouddatabasesv5.AddAllowlistEntryResponse, *core.DetailedResponse, error)
+	DeleteAllowlistEntryFunc             func(ctx context.Context, deleteAllowlistEntryOptions *clouddatabasesv5.DeleteAllowlistEntryOptions) (*clouddatabasesv5.DeleteAllowlistEntryResponse, *core.DetailedResponse, error)
+	CreateCapabilityFunc                 func(ctx context.Context, createCapabilityOptions *clouddatabasesv5.CreateCapabilityOptions) (*clouddatabasesv5.CreateCapabilityResponse, *core.DetailedResponse, error)
+	GetDeploymentCapabilityFunc          func(ctx context.Context, getDeploymentCapabilityOptions *clouddatabasesv5.GetDeploymentCapabilityOptions) (*clouddatabasesv5.GetDeploymentCapabilityResponse, *core.DetailedResponse, error)
+	SetDatabaseInplaceVersionUpgradeFunc func(ctx context.Context, setDatabaseInplaceVersionUpgradeOptions *clouddatabasesv5.SetDatabaseInplaceVersionUpgradeOptions) (*clouddatabasesv5.SetDatabaseInplaceVersionUpgradeResponse, *core.DetailedResponse, error)
+	WaitForTaskFunc                      func(ctx context.Context, taskID string, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (*clouddatabasesv5.Task, error)
+
+	mu    sync.Mutex
+	calls []Call
+	tasks map[string]*clouddatabasesv5.Task
+}
+
+var _ clouddatabasesv5.CloudDatabasesAPI = (*Client)(nil)
+
+// Call : A recorded invocation of an operation.
+type Call struct {
+	// Name of the operation, such as "SetAllowlist" or "WaitForTask".
+	Operation string
+
+	// The options passed to the operation; the task ID for WaitForTask.
+	Options interface{}
+}
+
+// NewClient : Instantiate Client
+func NewClient() *Client {
+	return &Client{}
+}
+
+// Calls returns every call made so far, in order.
+func (c *Client) Calls() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Call(nil), c.calls...)
+}
+
+// CallsTo returns the calls made so far to the specified operation, in order.
+func (c *Client) CallsTo(operation string) (calls []Call) {
+	for _, call := range c.Calls() {
+		if call.Operation == operation {
+			calls = append(calls, call)
+		}
+	}
+	return
+}
+
+// Reset forgets the recorded calls and issued tasks; scripted Func fields are kept.
+func (c *Client) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = nil
+	c.tasks = nil
+}
+
+func (c *Client) record(operation string, options interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, Call{Operation: operation, Options: options})
+}
+
+// newTask issues a queued task for an operation that was not scripted.
+func (c *Client) newTask(resourceType string) *clouddatabasesv5.Task {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.tasks == nil {
+		c.tasks = make(map[string]*clouddatabasesv5.Task)
+	}
+	task := &clouddatabasesv5.Task{
+		ID:              core.StringPtr(fmt.Sprintf("stub-task-%d", len(c.tasks)+1)),
+		ResourceType:    core.StringPtr(resourceType),
+		Status:          core.StringPtr(clouddatabasesv5.TaskStatusQueuedConst),
+		ProgressPercent: core.Int64Ptr(0),
+	}
+	c.tasks[*task.ID] = task
+	copied := *task
+	return &copied
+}
+
+func okResponse(result interface{}) *core.DetailedResponse {
+	return &core.DetailedResponse{
+		StatusCode: 200,
+		Result:     result,
+	}
+}
+
+// Return : A scripted operation that always succeeds with the specified result, e.g.
+//
+//	client.GetBackupInfoFunc = stub.Return[clouddatabasesv5.GetBackupInfoOptions](&clouddatabasesv5.GetBackupInfoResponse{Backup: backup})
+func Return[O any, R any](result *R) func(context.Context, *O) (*R, *core.DetailedResponse, error) {
+	return func(context.Context, *O) (*R, *core.DetailedResponse, error) {
+		return result, okResponse(result), nil
+	}
+}
+
+// Fail : A scripted operation that always fails with the specified HTTP status code and error, e.g.
+//
+//	client.SetAllowlistFunc = stub.Fail[clouddatabasesv5.SetAllowlistOptions, clouddatabasesv5.SetAllowlistResponse](412, err)
+func Fail[O any, R any](statusCode int, err error) func(context.Context, *O) (*R, *core.DetailedResponse, error) {
+	return func(context.Context, *O) (*R, *core.DetailedResponse, error) {
+		return nil, &core.DetailedResponse{StatusCode: statusCode}, err
+	}
+}
+
+// ListDeployables records the call and returns the scripted result of ListDeployablesFunc.
+func (c *Client) ListDeployables(listDeployablesOptions *clouddatabasesv5.ListDeployablesOptions) (result *clouddatabasesv5.ListDeployablesResponse, response *core.DetailedResponse, err error) {
+	return c.ListDeployablesWithContext(context.Background(), listDeployablesOptions)
+}
+
+// ListDeployablesWithContext records the call and returns the scripted result of ListDeployablesFunc.
+func (c *Client) ListDeployablesWithContext(ctx context.Context, listDeployablesOptions *clouddatabasesv5.ListDeployablesOptions) (result *clouddatabasesv5.ListDeployablesResponse, response *core.DetailedResponse, err error) {
+	c.record("ListDeployables", listDeployablesOptions)
+	if c.ListDeployablesFunc != nil {
+		return c.ListDeployablesFunc(ctx, listDeployablesOptions)
+	}
+	result = new(clouddatabasesv5.ListDeployablesResponse)
+	return result, okResponse(result), nil
+}
+
+// ListRegions records the call and returns the scripted result of ListRegionsFunc.
+func (c *Client) ListRegions(listRegionsOptions *clouddatabasesv5.ListRegionsOptions) (result *clouddatabasesv5.ListRegionsResponse, response *core.DetailedResponse, err error) {
+	return c.ListRegionsWithContext(context.Background(), listRegionsOptions)
+}
+
+// ListRegionsWithContext records the call and returns the scripted result of ListRegionsFunc.
+func (c *Client) ListRegionsWithContext(ctx context.Context, listRegionsOptions *clouddatabasesv5.ListRegionsOptions) (result *clouddatabasesv5.ListRegionsResponse, response *core.DetailedResponse, err error) {
+	c.record("ListRegions", listRegionsOptions)
+	if c.ListRegionsFunc != nil {
+		return c.ListRegionsFunc(ctx, listRegionsOptions)
+	}
+	result = new(clouddatabasesv5.ListRegionsResponse)
+	return result, okResponse(result), nil
+}
+
+// GetDeploymentInfo records the call and returns the scripted result of GetDeploymentInfoFunc.
+func (c *Client) GetDeploymentInfo(getDeploymentInfoOptions *clouddatabasesv5.GetDeploymentInfoOptions) (result *clouddatabasesv5.GetDeploymentInfoResponse, response *core.DetailedResponse, err error) {
+	return c.GetDeploymentInfoWithContext(context.Background(), getDeploymentInfoOptions)
+}
+
+// GetDeploymentInfoWithContext records the call and returns the scripted result of GetDeploymentInfoFunc.
+func (c *Client) GetDeploymentInfoWithContext(ctx context.Context, getDeploymentInfoOptions *clouddatabasesv5.GetDeploymentInfoOptions) (result *clouddatabasesv5.GetDeploymentInfoResponse, response *core.DetailedResponse, err error) {
+	c.record("GetDeploymentInfo", getDeploymentInfoOptions)
+	if c.GetDeploymentInfoFunc != nil {
+		return c.GetDeploymentInfoFunc(ctx, getDeploymentInfoOptions)
+	}
+	result = new(clouddatabasesv5.GetDeploymentInfoResponse)
+	return result, okResponse(result), nil
+}
+
+// CreateDatabaseUser records the call and returns the scripted result of CreateDatabaseUserFunc.
+func (c *Client) CreateDatabaseUser(createDatabaseUserOptions *clouddatabasesv5.CreateDatabaseUserOptions) (result *clouddatabasesv5.CreateDatabaseUserResponse, response *core.DetailedResponse, err error) {
+	return c.CreateDatabaseUserWithContext(context.Background(), createDatabaseUserOptions)
+}
+
+// CreateDatabaseUserWithContext records the call and returns the scripted result of CreateDatabaseUserFunc.
+func (c *Client) CreateDatabaseUserWithContext(ctx context.Context, createDatabaseUserOptions *clouddatabasesv5.CreateDatabaseUserOptions) (result *clouddatabasesv5.CreateDatabaseUserResponse, response *core.DetailedResponse, err error) {
+	c.record("CreateDatabaseUser", createDatabaseUserOptions)
+	if c.CreateDatabaseUserFunc != nil {
+		return c.CreateDatabaseUserFunc(ctx, createDatabaseUserOptions)
+	}
+	result = new(clouddatabasesv5.CreateDatabaseUserResponse)
+	result.Task = c.newTask(clouddatabasesv5.TaskResourceTypeUserConst)
+	return result, okResponse(result), nil
+}
+
+// UpdateUser records the call and returns the scripted result of UpdateUserFunc.
+func (c *Client) UpdateUser(updateUserOptions *clouddatabasesv5.UpdateUserOptions) (result *clouddatabasesv5.UpdateUserResponse, response *core.DetailedResponse, err error) {
+	return c.UpdateUserWithContext(context.Background(), updateUserOptions)
+}
+
+// UpdateUserWithContext records the call and returns the scripted result of UpdateUserFunc.
+func (c *Client) UpdateUserWithContext(ctx context.Context, updateUserOptions *clouddatabasesv5.UpdateUserOptions) (result *clouddatabasesv5.UpdateUserResponse, response *core.DetailedResponse, err error) {
+	c.record("UpdateUser", updateUserOptions)
+	if c.UpdateUserFunc != nil {
+		return c.UpdateUserFunc(ctx, updateUserOptions)
+	}
+	result = new(clouddatabasesv5.UpdateUserResponse)
+	result.Task = c.newTask(clouddatabasesv5.TaskResourceTypeUserConst)
+	return result, okResponse(result), nil
+}
+
+// DeleteDatabaseUser records the call and returns the scripted result of DeleteDatabaseUserFunc.
+func (c *Client) DeleteDatabaseUser(deleteDatabaseUserOptions *clouddatabasesv5.DeleteDatabaseUserOptions) (result *clouddatabasesv5.DeleteDatabaseUserResponse, response *core.DetailedResponse, err error) {
+	return c.DeleteDatabaseUserWithContext(context.Background(), deleteDatabaseUserOptions)
+}
+
+// DeleteDatabaseUserWithContext records the call and returns the scripted result of DeleteDatabaseUserFunc.
+func (c *Client) DeleteDatabaseUserWithContext(ctx context.Context, deleteDatabaseUserOptions *clouddatabasesv5.DeleteDatabaseUserOptions) (result *clouddatabasesv5.DeleteDatabaseUserResponse, response *core.DetailedResponse, err error) {
+	c.record("DeleteDatabaseUser", deleteDatabaseUserOptions)
+	if c.DeleteDatabaseUserFunc != nil {
+		return c.DeleteDatabaseUserFunc(ctx, deleteDatabaseUserOptions)
+	}
+	result = new(clouddatabasesv5.DeleteDatabaseUserResponse)
+	result.Task = c.newTask(clouddatabasesv5.TaskResourceTypeUserConst)
+	return result, okResponse(result), nil
+}
+
+// GetDatabaseConfigurationSchema records the call and returns the scripted result of GetDatabaseConfigurationSchemaFunc.
+func (c *Client) GetDatabaseConfigurationSchema(getDatabaseConfigurationSchemaOptions *clouddatabasesv5.GetDatabaseConfigurationSchemaOptions) (result *clouddatabasesv5.GetDatabaseConfigurationSchemaResponse, response *core.DetailedResponse, err error) {
+	return c.GetDatabaseConfigurationSchemaWithContext(context.Background(), getDatabaseConfigurationSchemaOptions)
+}
+
+// GetDatabaseConfigurationSchemaWithContext records the call and returns the scripted result of GetDatabaseConfigurationSchemaFunc.
+func (c *Client) GetDatabaseConfigurationSchemaWithContext(ctx context.Context, getDatabaseConfigurationSchemaOptions *clouddatabasesv5.GetDatabaseConfigurationSchemaOptions) (result *clouddatabasesv5.GetDatabaseConfigurationSchemaResponse, response *core.DetailedResponse, err error) {
+	c.record("GetDatabaseConfigurationSchema", getDatabaseConfigurationSchemaOptions)
+	if c.GetDatabaseConfigurationSchemaFunc != nil {
+		return c.GetDatabaseConfigurationSchemaFunc(ctx, getDatabaseConfigurationSchemaOptions)
+	}
+	result = new(clouddatabasesv5.GetDatabaseConfigurationSchemaResponse)
+	return result, okResponse(result), nil
+}
+
+// UpdateDatabaseConfiguration records the call and returns the scripted result of UpdateDatabaseConfigurationFunc.
+func (c *Client) UpdateDatabaseConfiguration(updateDatabaseConfigurationOptions *clouddatabasesv5.UpdateDatabaseConfigurationOptions) (result *clouddatabasesv5.UpdateDatabaseConfigurationResponse, response *core.DetailedResponse, err error) {
+	return c.UpdateDatabaseConfigurationWithContext(context.Background(), updateDatabaseConfigurationOptions)
+}
+
+// UpdateDatabaseConfigurationWithContext records the call and returns the scripted result of UpdateDatabaseConfigurationFunc.
+func (c *Client) UpdateDatabaseConfigurationWithContext(ctx context.Context, updateDatabaseConfigurationOptions *clouddatabasesv5.UpdateDatabaseConfigurationOptions) (result *clouddatabasesv5.UpdateDatabaseConfigurationResponse, response *core.DetailedResponse, err error) {
+	c.record("UpdateDatabaseConfiguration", updateDatabaseConfigurationOptions)
+	if c.UpdateDatabaseConfigurationFunc != nil {
+		return c.UpdateDatabaseConfigurationFunc(ctx, updateDatabaseConfigurationOptions)
+	}
+	result = new(clouddatabasesv5.UpdateDatabaseConfigurationResponse)
+	result.Task = c.newTask(clouddatabasesv5.TaskResourceTypeConfigurationConst)
+	return result, okResponse(result), nil
+}
+
+// ListRemotes records the call and returns the scripted result of ListRemotesFunc.
+func (c *Client) ListRemotes(listRemotesOptions *clouddatabasesv5.ListRemotesOptions) (result *clouddatabasesv5.ListRemotesResponse, response *core.DetailedResponse, err error) {
+	return c.ListRemotesWithContext(context.Background(), listRemotesOptions)
+}
+
+// ListRemotesWithContext records the call and returns the scripted result of ListRemotesFunc.
+func (c *Client) ListRemotesWithContext(ctx context.Context, listRemotesOptions *clouddatabasesv5.ListRemotesOptions) (result *clouddatabasesv5.ListRemotesResponse, response *core.DetailedResponse, err error) {
+	c.record("ListRemotes", listRemotesOptions)
+	if c.ListRemotesFunc != nil {
+		return c.ListRemotesFunc(ctx, listRemotesOptions)
+	}
+	result = new(clouddatabasesv5.ListRemotesResponse)
+	return result, okResponse(result), nil
+}
+
+// ResyncReplica records the call and returns the scripted result of ResyncReplicaFunc.
+func (c *Client) ResyncReplica(resyncReplicaOptions *clouddatabasesv5.ResyncReplicaOptions) (result *clouddatabasesv5.ResyncReplicaResponse, response *core.DetailedResponse, err error) {
+	return c.ResyncReplicaWithContext(context.Background(), resyncReplicaOptions)
+}
+
+// ResyncReplicaWithContext records the call and returns the scripted result of ResyncReplicaFunc.
+func (c *Client) ResyncReplicaWithContext(ctx context.Context, resyncReplicaOptions *clouddatabasesv5.ResyncReplicaOptions) (result *clouddatabasesv5.ResyncReplicaResponse, response *core.DetailedResponse, err error) {
+	c.record("ResyncReplica", resyncReplicaOptions)
+	if c.ResyncReplicaFunc != nil {
+		return c.ResyncReplicaFunc(ctx, resyncReplicaOptions)
+	}
+	result = new(clouddatabasesv5.ResyncReplicaResponse)
+	result.Task = c.newTask(clouddatabasesv5.TaskResourceTypeInstanceConst)
+	return result, okResponse(result), nil
+}
+
+// PromoteReadOnlyReplica records the call and returns the scripted result of PromoteReadOnlyReplicaFunc.
+func (c *Client) PromoteReadOnlyReplica(promoteReadOnlyReplicaOptions *clouddatabasesv5.PromoteReadOnlyReplicaOptions) (result *clouddatabasesv5.PromoteReadOnlyReplicaResponse, response *core.DetailedResponse, err error) {
+	return c.PromoteReadOnlyReplicaWithContext(context.Background(), promoteReadOnlyReplicaOptions)
+}
+
+// PromoteReadOnlyReplicaWithContext records the call and returns the scripted result of PromoteReadOnlyReplicaFunc.
+func (c *Client) PromoteReadOnlyReplicaWithContext(ctx context.Context, promoteReadOnlyReplicaOptions *clouddatabasesv5.PromoteReadOnlyReplicaOptions) (result *clouddatabasesv5.PromoteReadOnlyReplicaResponse, response *core.DetailedResponse, err error) {
+	c.record("PromoteReadOnlyReplica", promoteReadOnlyReplicaOptions)
+	if c.PromoteReadOnlyReplicaFunc != nil {
+		return c.PromoteReadOnlyReplicaFunc(ctx, promoteReadOnlyReplicaOptions)
+	}
+	result = new(clouddatabasesv5.PromoteReadOnlyReplicaResponse)
+	result.Task = c.newTask(clouddatabasesv5.TaskResourceTypeInstanceConst)
+	return result, okResponse(result), nil
+}
+
+// ListDeploymentTasks records the call and returns the scripted result of ListDeploymentTasksFunc.
+func (c *Client) ListDeploymentTasks(listDeploymentTasksOptions *clouddatabasesv5.ListDeploymentTasksOptions) (result *clouddatabasesv5.Tasks, response *core.DetailedResponse, err error) {
+	return c.ListDeploymentTasksWithContext(context.Background(), listDeploymentTasksOptions)
+}
+
+// ListDeploymentTasksWithContext records the call and returns the scripted result of ListDeploymentTasksFunc.
+func (c *Client) ListDeploymentTasksWithContext(ctx context.Context, listDeploymentTasksOptions *clouddatabasesv5.ListDeploymentTasksOptions) (result *clouddatabasesv5.Tasks, response *core.DetailedResponse, err error) {
+	c.record("ListDeploymentTasks", listDeploymentTasksOptions)
+	if c.ListDeploymentTasksFunc != nil {
+		return c.ListDeploymentTasksFunc(ctx, listDeploymentTasksOptions)
+	}
+	result = new(clouddatabasesv5.Tasks)
+	return result, okResponse(result), nil
+}
+
+// GetTask records the call and returns the scripted result of GetTaskFunc.
+func (c *Client) GetTask(getTaskOptions *clouddatabasesv5.GetTaskOptions) (result *clouddatabasesv5.GetTaskResponse, response *core.DetailedResponse, err error) {
+	return c.GetTaskWithContext(context.Background(), getTaskOptions)
+}
+
+// GetTaskWithContext records the call and returns the scripted result of GetTaskFunc.
+func (c *Client) GetTaskWithContext(ctx context.Context, getTaskOptions *clouddatabasesv5.GetTaskOptions) (result *clouddatabasesv5.GetTaskResponse, response *core.DetailedResponse, err error) {
+	c.record("GetTask", getTaskOptions)
+	if c.GetTaskFunc != nil {
+		return c.GetTaskFunc(ctx, getTaskOptions)
+	}
+	result = new(clouddatabasesv5.GetTaskResponse)
+	return result, okResponse(result), nil
+}
+
+// GetBackupInfo records the call and returns the scripted result of GetBackupInfoFunc.
+func (c *Client) GetBackupInfo(getBackupInfoOptions *clouddatabasesv5.GetBackupInfoOptions) (result *clouddatabasesv5.GetBackupInfoResponse, response *core.DetailedResponse, err error) {
+	return c.GetBackupInfoWithContext(context.Background(), getBackupInfoOptions)
+}
+
+// GetBackupInfoWithContext records the call and returns the scripted result of GetBackupInfoFunc.
+func (c *Client) GetBackupInfoWithContext(ctx context.Context, getBackupInfoOptions *clouddatabasesv5.GetBackupInfoOptions) (result *clouddatabasesv5.GetBackupInfoResponse, response *core.DetailedResponse, err error) {
+	c.record("GetBackupInfo", getBackupInfoOptions)
+	if c.GetBackupInfoFunc != nil {
+		return c.GetBackupInfoFunc(ctx, getBackupInfoOptions)
+	}
+	result = new(clouddatabasesv5.GetBackupInfoResponse)
+	return result, okResponse(result), nil
+}
+
+// ListDeploymentBackups records the call and returns the scripted result of ListDeploymentBackupsFunc.
+func (c *Client) ListDeploymentBackups(listDeploymentBackupsOptions *clouddatabasesv5.ListDeploymentBackupsOptions) (result *clouddatabasesv5.Backups, response *core.DetailedResponse, err error) {
+	return c.ListDeploymentBackupsWithContext(context.Background(), listDeploymentBackupsOptions)
+}
+
+// ListDeploymentBackupsWithContext records the call and returns the scripted result of ListDeploymentBackupsFunc.
+func (c *Client) ListDeploymentBackupsWithContext(ctx context.Context, listDeploymentBackupsOptions *clouddatabasesv5.ListDeploymentBackupsOptions) (result *clouddatabasesv5.Backups, response *core.DetailedResponse, err error) {
+	c.record("ListDeploymentBackups", listDeploymentBackupsOptions)
+	if c.ListDeploymentBackupsFunc != nil {
+		return c.ListDeploymentBackupsFunc(ctx, listDeploymentBackupsOptions)
+	}
+	result = new(clouddatabasesv5.Backups)
+	return result, okResponse(result), nil
+}
+
+// StartOndemandBackup records the call and returns the scripted result of StartOndemandBackupFunc.
+func (c *Client) StartOndemandBackup(startOndemandBackupOptions *clouddatabasesv5.StartOndemandBackupOptions) (result *clouddatabasesv5.StartOndemandBackupResponse, response *core.DetailedResponse, err error) {
+	return c.StartOndemandBackupWithContext(context.Background(), startOndemandBackupOptions)
+}
+
+// StartOndemandBackupWithContext records the call and returns the scripted result of StartOndemandBackupFunc.
+func (c *Client) StartOndemandBackupWithContext(ctx context.Context, startOndemandBackupOptions *clouddatabasesv5.StartOndemandBackupOptions) (result *clouddatabasesv5.StartOndemandBackupResponse, response *core.DetailedResponse, err error) {
+	c.record("StartOndemandBackup", startOndemandBackupOptions)
+	if c.StartOndemandBackupFunc != nil {
+		return c.StartOndemandBackupFunc(ctx, startOndemandBackupOptions)
+	}
+	result = new(clouddatabasesv5.StartOndemandBackupResponse)
+	result.Task = c.newTask(clouddatabasesv5.TaskResourceTypeBackupConst)
+	return result, okResponse(result), nil
+}
+
+// GetPitrData records the call and returns the scripted result of GetPitrDataFunc.
+func (c *Client) GetPitrData(getPitrDataOptions *clouddatabasesv5.GetPitrDataOptions) (result *clouddatabasesv5.GetPitrDataResponse, response *core.DetailedResponse, err error) {
+	return c.GetPitrDataWithContext(context.Background(), getPitrDataOptions)
+}
+
+// GetPitrDataWithContext records the call and returns the scripted result of GetPitrDataFunc.
+func (c *Client) GetPitrDataWithContext(ctx context.Context, getPitrDataOptions *clouddatabasesv5.GetPitrDataOptions) (result *clouddatabasesv5.GetPitrDataResponse, response *core.DetailedResponse, err error) {
+	c.record("GetPitrData", getPitrDataOptions)
+	if c.GetPitrDataFunc != nil {
+		return c.GetPitrDataFunc(ctx, getPitrDataOptions)
+	}
+	result = new(clouddatabasesv5.GetPitrDataResponse)
+	return result, okResponse(result), nil
+}
+
+// GetConnection records the call and returns the scripted result of GetConnectionFunc.
+func (c *Client) GetConnection(getConnectionOptions *clouddatabasesv5.GetConnectionOptions) (result *clouddatabasesv5.GetConnectionResponse, response *core.DetailedResponse, err error) {
+	return c.GetConnectionWithContext(context.Background(), getConnectionOptions)
+}
+
+// GetConnectionWithContext records the call and returns the scripted result of GetConnectionFunc.
+func (c *Client) GetConnectionWithContext(ctx context.Context, getConnectionOptions *clouddatabasesv5.GetConnectionOptions) (result *clouddatabasesv5.GetConnectionResponse, response *core.DetailedResponse, err error) {
+	c.record("GetConnection", getConnectionOptions)
+	if c.GetConnectionFunc != nil {
+		return c.GetConnectionFunc(ctx, getConnectionOptions)
+	}
+	result = new(clouddatabasesv5.GetConnectionResponse)
+	return result, okResponse(result), nil
+}
+
+// CompleteConnection records the call and returns the scripted result of CompleteConnectionFunc.
+func (c *Client) CompleteConnection(completeConnectionOptions *clouddatabasesv5.CompleteConnectionOptions) (result *clouddatabasesv5.CompleteConnectionResponse, response *core.DetailedResponse, err error) {
+	return c.CompleteConnectionWithContext(context.Background(), completeConnectionOptions)
+}
+
+// CompleteConnectionWithContext records the call and returns the scripted result of CompleteConnectionFunc.
+func (c *Client) CompleteConnectionWithContext(ctx context.Context, completeConnectionOptions *clouddatabasesv5.CompleteConnectionOptions) (result *clouddatabasesv5.CompleteConnectionResponse, response *core.DetailedResponse, err error) {
+	c.record("CompleteConnection", completeConnectionOptions)
+	if c.CompleteConnectionFunc != nil {
+		return c.CompleteConnectionFunc(ctx, completeConnectionOptions)
+	}
+	result = new(clouddatabasesv5.CompleteConnectionResponse)
+	return result, okResponse(result), nil
+}
+
+// ListDeploymentScalingGroups records the call and returns the scripted result of ListDeploymentScalingGroupsFunc.
+func (c *Client) ListDeploymentScalingGroups(listDeploymentScalingGroupsOptions *clouddatabasesv5.ListDeploymentScalingGroupsOptions) (result *clouddatabasesv5.ListDeploymentScalingGroupsResponse, response *core.DetailedResponse, err error) {
+	return c.ListDeploymentScalingGroupsWithContext(context.Background(), listDeploymentScalingGroupsOptions)
+}
+
+// ListDeploymentScalingGroupsWithContext records the call and returns the scripted result of ListDeploymentScalingGroupsFunc.
+func (c *Client) ListDeploymentScalingGroupsWithContext(ctx context.Context, listDeploymentScalingGroupsOptions *clouddatabasesv5.ListDeploymentScalingGroupsOptions) (result *clouddatabasesv5.ListDeploymentScalingGroupsResponse, response *core.DetailedResponse, err error) {
+	c.record("ListDeploymentScalingGroups", listDeploymentScalingGroupsOptions)
+	if c.ListDeploymentScalingGroupsFunc != nil {
+		return c.ListDeploymentScalingGroupsFunc(ctx, listDeploymentScalingGroupsOptions)
+	}
+	result = new(clouddatabasesv5.ListDeploymentScalingGroupsResponse)
+	return result, okResponse(result), nil
+}
+
+// GetDefaultScalingGroups records the call and returns the scripted result of GetDefaultScalingGroupsFunc.
+func (c *Client) GetDefaultScalingGroups(getDefaultScalingGroupsOptions *clouddatabasesv5.GetDefaultScalingGroupsOptions) (result *clouddatabasesv5.GetDefaultScalingGroupsResponse, response *core.DetailedResponse, err error) {
+	return c.GetDefaultScalingGroupsWithContext(context.Background(), getDefaultScalingGroupsOptions)
+}
+
+// GetDefaultScalingGroupsWithContext records the call and returns the scripted result of GetDefaultScalingGroupsFunc.
+func (c *Client) GetDefaultScalingGroupsWithContext(ctx context.Context, getDefaultScalingGroupsOptions *clouddatabasesv5.GetDefaultScalingGroupsOptions) (result *clouddatabasesv5.GetDefaultScalingGroupsResponse, response *core.DetailedResponse, err error) {
+	c.record("GetDefaultScalingGroups", getDefaultScalingGroupsOptions)
+	if c.GetDefaultScalingGroupsFunc != nil {
+		return c.GetDefaultScalingGroupsFunc(ctx, getDefaultScalingGroupsOptions)
+	}
+	result = new(clouddatabasesv5.GetDefaultScalingGroupsResponse)
+	return result, okResponse(result), nil
+}
+
+// SetDeploymentScalingGroup records the call and returns the scripted result of SetDeploymentScalingGroupFunc.
+func (c *Client) SetDeploymentScalingGroup(setDeploymentScalingGroupOptions *clouddatabasesv5.SetDeploymentScalingGroupOptions) (result *clouddatabasesv5.SetDeploymentScalingGroupResponse, response *core.DetailedResponse, err error) {
+	return c.SetDeploymentScalingGroupWithContext(context.Background(), setDeploymentScalingGroupOptions)
+}
+
+// SetDeploymentScalingGroupWithContext records the call and returns the scripted result of SetDeploymentScalingGroupFunc.
+func (c *Client) SetDeploymentScalingGroupWithContext(ctx context.Context, setDeploymentScalingGroupOptions *clouddatabasesv5.SetDeploymentScalingGroupOptions) (result *clouddatabasesv5.SetDeploymentScalingGroupResponse, response *core.DetailedResponse, err error) {
+	c.record("SetDeploymentScalingGroup", setDeploymentScalingGroupOptions)
+	if c.SetDeploymentScalingGroupFunc != nil {
+		return c.SetDeploymentScalingGroupFunc(ctx, setDeploymentScalingGroupOptions)
+	}
+	result = new(clouddatabasesv5.SetDeploymentScalingGroupResponse)
+	result.Task = c.newTask(clouddatabasesv5.TaskResourceTypeInstanceConst)
+	return result, okResponse(result), nil
+}
+
+// GetAutoscalingConditions records the call and returns the scripted result of GetAutoscalingConditionsFunc.
+func (c *Client) GetAutoscalingConditions(getAutoscalingConditionsOptions *clouddatabasesv5.GetAutoscalingConditionsOptions) (result *clouddatabasesv5.AutoscalingGroup, response *core.DetailedResponse, err error) {
+	return c.GetAutoscalingConditionsWithContext(context.Background(), getAutoscalingConditionsOptions)
+}
+
+// GetAutoscalingConditionsWithContext records the call and returns the scripted result of GetAutoscalingConditionsFunc.
+func (c *Client) GetAutoscalingConditionsWithContext(ctx context.Context, getAutoscalingConditionsOptions *clouddatabasesv5.GetAutoscalingConditionsOptions) (result *clouddatabasesv5.AutoscalingGroup, response *core.DetailedResponse, err error) {
+	c.record("GetAutoscalingConditions", getAutoscalingConditionsOptions)
+	if c.GetAutoscalingConditionsFunc != nil {
+		return c.GetAutoscalingConditionsFunc(ctx, getAutoscalingConditionsOptions)
+	}
+	result = new(clouddatabasesv5.AutoscalingGroup)
+	return result, okResponse(result), nil
+}
+
+// SetAutoscalingConditions records the call and returns the scripted result of SetAutoscalingConditionsFunc.
+func (c *Client) SetAutoscalingConditions(setAutoscalingConditionsOptions *clouddatabasesv5.SetAutoscalingConditionsOptions) (result *clouddatabasesv5.SetAutoscalingConditionsResponse, response *core.DetailedResponse, err error) {
+	return c.SetAutoscalingConditionsWithContext(context.Background(), setAutoscalingConditionsOptions)
+}
+
+// SetAutoscalingConditionsWithContext records the call and returns the scripted result of SetAutoscalingConditionsFunc.
+func (c *Client) SetAutoscalingConditionsWithContext(ctx context.Context, setAutoscalingConditionsOptions *clouddatabasesv5.SetAutoscalingConditionsOptions) (result *clouddatabasesv5.SetAutoscalingConditionsResponse, response *core.DetailedResponse, err error) {
+	c.record("SetAutoscalingConditions", setAutoscalingConditionsOptions)
+	if c.SetAutoscalingConditionsFunc != nil {
+		return c.SetAutoscalingConditionsFunc(ctx, setAutoscalingConditionsOptions)
+	}
+	result = new(clouddatabasesv5.SetAutoscalingConditionsResponse)
+	result.Task = c.newTask(clouddatabasesv5.TaskResourceTypeInstanceConst)
+	return result, okResponse(result), nil
+}
+
+// KillConnections records the call and returns the scripted result of KillConnectionsFunc.
+func (c *Client) KillConnections(killConnectionsOptions *clouddatabasesv5.KillConnectionsOptions) (result *clouddatabasesv5.KillConnectionsResponse, response *core.DetailedResponse, err error) {
+	return c.KillConnectionsWithContext(context.Background(), killConnectionsOptions)
+}
+
+// KillConnectionsWithContext records the call and returns the scripted result of KillConnectionsFunc.
+func (c *Client) KillConnectionsWithContext(ctx context.Context, killConnectionsOptions *clouddatabasesv5.KillConnectionsOptions) (result *clouddatabasesv5.KillConnectionsResponse, response *core.DetailedResponse, err error) {
+	c.record("KillConnections", killConnectionsOptions)
+	if c.KillConnectionsFunc != nil {
+		return c.KillConnectionsFunc(ctx, killConnectionsOptions)
+	}
+	result = new(clouddatabasesv5.KillConnectionsResponse)
+	result.Task = c.newTask(clouddatabasesv5.TaskResourceTypeInstanceConst)
+	return result, okResponse(result), nil
+}
+
+// CreateLogicalReplicationSlot records the call and returns the scripted result of CreateLogicalReplicationSlotFunc.
+func (c *Client) CreateLogicalReplicationSlot(createLogicalReplicationSlotOptions *clouddatabasesv5.CreateLogicalReplicationSlotOptions) (result *clouddatabasesv5.CreateLogicalReplicationSlotResponse, response *core.DetailedResponse, err error) {
+	return c.CreateLogicalReplicationSlotWithContext(context.Background(), createLogicalReplicationSlotOptions)
+}
+
+// CreateLogicalReplicationSlotWithContext records the call and returns the scripted result of CreateLogicalReplicationSlotFunc.
+func (c *Client) CreateLogicalReplicationSlotWithContext(ctx context.Context, createLogicalReplicationSlotOptions *clouddatabasesv5.CreateLogicalReplicationSlotOptions) (result *clouddatabasesv5.CreateLogicalReplicationSlotResponse, response *core.DetailedResponse, err error) {
+	c.record("CreateLogicalReplicationSlot", createLogicalReplicationSlotOptions)
+	if c.CreateLogicalReplicationSlotFunc != nil {
+		return c.CreateLogicalReplicationSlotFunc(ctx, createLogicalReplicationSlotOptions)
+	}
+	result = new(clouddatabasesv5.CreateLogicalReplicationSlotResponse)
+	result.Task = c.newTask(clouddatabasesv5.TaskResourceTypeInstanceConst)
+	return result, okResponse(result), nil
+}
+
+// DeleteLogicalReplicationSlot records the call and returns the scripted result of DeleteLogicalReplicationSlotFunc.
+func (c *Client) DeleteLogicalReplicationSlot(deleteLogicalReplicationSlotOptions *clouddatabasesv5.DeleteLogicalReplicationSlotOptions) (result *clouddatabasesv5.DeleteLogicalReplicationSlotResponse, response *core.DetailedResponse, err error) {
+	return c.DeleteLogicalReplicationSlotWithContext(context.Background(), deleteLogicalReplicationSlotOptions)
+}
+
+// DeleteLogicalReplicationSlotWithContext records the call and returns the scripted result of DeleteLogicalReplicationSlotFunc.
+func (c *Client) DeleteLogicalReplicationSlotWithContext(ctx context.Context, deleteLogicalReplicationSlotOptions *clouddatabasesv5.DeleteLogicalReplicationSlotOptions) (result *clouddatabasesv5.DeleteLogicalReplicationSlotResponse, response *core.DetailedResponse, err error) {
+	c.record("DeleteLogicalReplicationSlot", deleteLogicalReplicationSlotOptions)
+	if c.DeleteLogicalReplicationSlotFunc != nil {
+		return c.DeleteLogicalReplicationSlotFunc(ctx, deleteLogicalReplicationSlotOptions)
+	}
+	result = new(clouddatabasesv5.DeleteLogicalReplicationSlotResponse)
+	result.Task = c.newTask(clouddatabasesv5.TaskResourceTypeInstanceConst)
+	return result, okResponse(result), nil
+}
+
+// GetAllowlist records the call and returns the scripted result of GetAllowlistFunc.
+func (c *Client) GetAllowlist(getAllowlistOptions *clouddatabasesv5.GetAllowlistOptions) (result *clouddatabasesv5.GetAllowlistResponse, response *core.DetailedResponse, err error) {
+	return c.GetAllowlistWithContext(context.Background(), getAllowlistOptions)
+}
+
+// GetAllowlistWithContext records the call and returns the scripted result of GetAllowlistFunc.
+func (c *Client) GetAllowlistWithContext(ctx context.Context, getAllowlistOptions *clouddatabasesv5.GetAllowlistOptions) (result *clouddatabasesv5.GetAllowlistResponse, response *core.DetailedResponse, err error) {
+	c.record("GetAllowlist", getAllowlistOptions)
+	if c.GetAllowlistFunc != nil {
+		return c.GetAllowlistFunc(ctx, getAllowlistOptions)
+	}
+	result = new(clouddatabasesv5.GetAllowlistResponse)
+	return result, okResponse(result), nil
+}
+
+// SetAllowlist records the call and returns the scripted result of SetAllowlistFunc.
+func (c *Client) SetAllowlist(setAllowlistOptions *clouddatabasesv5.SetAllowlistOptions) (result *clouddatabasesv5.SetAllowlistResponse, response *core.DetailedResponse, err error) {
+	return c.SetAllowlistWithContext(context.Background(), setAllowlistOptions)
+}
+
+// SetAllowlistWithContext records the call and returns the scripted result of SetAllowlistFunc.
+func (c *Client) SetAllowlistWithContext(ctx context.Context, setAllowlistOptions *clouddatabasesv5.SetAllowlistOptions) (result *clouddatabasesv5.SetAllowlistResponse, response *core.DetailedResponse, err error) {
+	c.record("SetAllowlist", setAllowlistOptions)
+	if c.SetAllowlistFunc != nil {
+		return c.SetAllowlistFunc(ctx, setAllowlistOptions)
+	}
+	result = new(clouddatabasesv5.SetAllowlistResponse)
+	result.Task = c.newTask(clouddatabasesv5.TaskResourceTypeIPConst)
+	return result, okResponse(result), nil
+}
+
+// AddAllowlistEntry records the call and returns the scripted result of AddAllowlistEntryFunc.
+func (c *Client) AddAllowlistEntry(addAllowlistEntryOptions *clouddatabasesv5.AddAllowlistEntryOptions) (result *clouddatabasesv5.AddAllowlistEntryResponse, response *core.DetailedResponse, err error) {
+	return c.AddAllowlistEntryWithContext(context.Background(), addAllowlistEntryOptions)
+}
+
+// AddAllowlistEntryWithContext records the call and returns the scripted result of AddAllowlistEntryFunc.
+func (c *Client) AddAllowlistEntryWithContext(ctx context.Context, addAllowlistEntryOptions *clouddatabasesv5.AddAllowlistEntryOptions) (result *clouddatabasesv5.AddAllowlistEntryResponse, response *core.DetailedResponse, err error) {
+	c.record("AddAllowlistEntry", addAllowlistEntryOptions)
+	if c.AddAllowlistEntryFunc != nil {
+		return c.AddAllowlistEntryFunc(ctx, addAllowlistEntryOptions)
+	}
+	result = new(clouddatabasesv5.AddAllowlistEntryResponse)
+	result.Task = c.newTask(clouddatabasesv5.TaskResourceTypeIPConst)
+	return result, okResponse(result), nil
+}
+
+// DeleteAllowlistEntry records the call and returns the scripted result of DeleteAllowlistEntryFunc.
+func (c *Client) DeleteAllowlistEntry(deleteAllowlistEntryOptions *clouddatabasesv5.DeleteAllowlistEntryOptions) (result *clouddatabasesv5.DeleteAllowlistEntryResponse, response *core.DetailedResponse, err error) {
+	return c.DeleteAllowlistEntryWithContext(context.Background(), deleteAllowlistEntryOptions)
+}
+
+// DeleteAllowlistEntryWithContext records the call and returns the scripted result of DeleteAllowlistEntryFunc.
+func (c *Client) DeleteAllowlistEntryWithContext(ctx context.Context, deleteAllowlistEntryOptions *clouddatabasesv5.DeleteAllowlistEntryOptions) (result *clouddatabasesv5.DeleteAllowlistEntryResponse, response *core.DetailedResponse, err error) {
+	c.record("DeleteAllowlistEntry", deleteAllowlistEntryOptions)
+	if c.DeleteAllowlistEntryFunc != nil {
+		return c.DeleteAllowlistEntryFunc(ctx, deleteAllowlistEntryOptions)
+	}
+	result = new(clouddatabasesv5.DeleteAllowlistEntryResponse)
+	result.Task = c.newTask(clouddatabasesv5.TaskResourceTypeIPConst)
+	return result, okResponse(result), nil
+}
+
+// CreateCapability records the call and returns the scripted result of CreateCapabilityFunc.
+func (c *Client) CreateCapability(createCapabilityOptions *clouddatabasesv5.CreateCapabilityOptions) (result *clouddatabasesv5.CreateCapabilityResponse, response *core.DetailedResponse, err error) {
+	return c.CreateCapabilityWithContext(context.Background(), createCapabilityOptions)
+}
+
+// CreateCapabilityWithContext records the call and returns the scripted result of CreateCapabilityFunc.
+func (c *Client) CreateCapabilityWithContext(ctx context.Context, createCapabilityOptions *clouddatabasesv5.CreateCapabilityOptions) (result *clouddatabasesv5.CreateCapabilityResponse, response *core.DetailedResponse, err error) {
+	c.record("CreateCapability", createCapabilityOptions)
+	if c.CreateCapabilityFunc != nil {
+		return c.CreateCapabilityFunc(ctx, createCapabilityOptions)
+	}
+	result = new(clouddatabasesv5.CreateCapabilityResponse)
+	return result, okResponse(result), nil
+}
+
+// GetDeploymentCapability records the call and returns the scripted result of GetDeploymentCapabilityFunc.
+func (c *Client) GetDeploymentCapability(getDeploymentCapabilityOptions *clouddatabasesv5.GetDeploymentCapabilityOptions) (result *clouddatabasesv5.GetDeploymentCapabilityResponse, response *core.DetailedResponse, err error) {
+	return c.GetDeploymentCapabilityWithContext(context.Background(), getDeploymentCapabilityOptions)
+}
+
+// GetDeploymentCapabilityWithContext records the call and returns the scripted result of GetDeploymentCapabilityFunc.
+func (c *Client) GetDeploymentCapabilityWithContext(ctx context.Context, getDeploymentCapabilityOptions *clouddatabasesv5.GetDeploymentCapabilityOptions) (result *clouddatabasesv5.GetDeploymentCapabilityResponse, response *core.DetailedResponse, err error) {
+	c.record("GetDeploymentCapability", getDeploymentCapabilityOptions)
+	if c.GetDeploymentCapabilityFunc != nil {
+		return c.GetDeploymentCapabilityFunc(ctx, getDeploymentCapabilityOptions)
+	}
+	result = new(clouddatabasesv5.GetDeploymentCapabilityResponse)
+	return result, okResponse(result), nil
+}
+
+// SetDatabaseInplaceVersionUpgrade records the call and returns the scripted result of SetDatabaseInplaceVersionUpgradeFunc.
+func (c *Client) SetDatabaseInplaceVersionUpgrade(setDatabaseInplaceVersionUpgradeOptions *clouddatabasesv5.SetDatabaseInplaceVersionUpgradeOptions) (result *clouddatabasesv5.SetDatabaseInplaceVersionUpgradeResponse, response *core.DetailedResponse, err error) {
+	return c.SetDatabaseInplaceVersionUpgradeWithContext(context.Background(), setDatabaseInplaceVersionUpgradeOptions)
+}
+
+// SetDatabaseInplaceVersionUpgradeWithContext records the call and returns the scripted result of SetDatabaseInplaceVersionUpgradeFunc.
+func (c *Client) SetDatabaseInplaceVersionUpgradeWithContext(ctx context.Context, setDatabaseInplaceVersionUpgradeOptions *clouddatabasesv5.SetDatabaseInplaceVersionUpgradeOptions) (result *clouddatabasesv5.SetDatabaseInplaceVersionUpgradeResponse, response *core.DetailedResponse, err error) {
+	c.record("SetDatabaseInplaceVersionUpgrade", setDatabaseInplaceVersionUpgradeOptions)
+	if c.SetDatabaseInplaceVersionUpgradeFunc != nil {
+		return c.SetDatabaseInplaceVersionUpgradeFunc(ctx, setDatabaseInplaceVersionUpgradeOptions)
+	}
+	result = new(clouddatabasesv5.SetDatabaseInplaceVersionUpgradeResponse)
+	result.Task = c.newTask(clouddatabasesv5.TaskResourceTypeUpgradeConst)
+	return result, okResponse(result), nil
+}
+
+// WaitForTask records the call and returns the scripted result of WaitForTaskFunc. By default, tasks issued by
+// unscripted operations are reported as completed, as is any other task ID.
+func (c *Client) WaitForTask(ctx context.Context, taskID string, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.Task, err error) {
+	c.record("WaitForTask", taskID)
+	if c.WaitForTaskFunc != nil {
+		return c.WaitForTaskFunc(ctx, taskID, waitForTaskOptions)
+	}
+	c.mu.Lock()
+	result = &clouddatabasesv5.Task{ID: core.StringPtr(taskID)}
+	if task, ok := c.tasks[taskID]; ok {
+		copied := *task
+		result = &copied
+	}
+	c.mu.Unlock()
+	result.Status = core.StringPtr(clouddatabasesv5.TaskStatusCompletedConst)
+	result.ProgressPercent = core.Int64Ptr(100)
+	return
+}
+
+// CreateDatabaseUserAndWait invokes CreateDatabaseUserWithContext and then WaitForTask, like the service client does.
+func (c *Client) CreateDatabaseUserAndWait(ctx context.Context, createDatabaseUserOptions *clouddatabasesv5.CreateDatabaseUserOptions, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.CreateDatabaseUserResponse, task *clouddatabasesv5.Task, response *core.DetailedResponse, err error) {
+	result, response, err = c.CreateDatabaseUserWithContext(ctx, createDatabaseUserOptions)
+	if err != nil || result == nil {
+		return
+	}
+	task = result.Task
+	if task != nil && task.ID != nil {
+		task, err = c.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+	}
+	return
+}
+
+// UpdateUserAndWait invokes UpdateUserWithContext and then WaitForTask, like the service client does.
+func (c *Client) UpdateUserAndWait(ctx context.Context, updateUserOptions *clouddatabasesv5.UpdateUserOptions, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.UpdateUserResponse, task *clouddatabasesv5.Task, response *core.DetailedResponse, err error) {
+	result, response, err = c.UpdateUserWithContext(ctx, updateUserOptions)
+	if err != nil || result == nil {
+		return
+	}
+	task = result.Task
+	if task != nil && task.ID != nil {
+		task, err = c.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+	}
+	return
+}
+
+// DeleteDatabaseUserAndWait invokes DeleteDatabaseUserWithContext and then WaitForTask, like the service client does.
+func (c *Client) DeleteDatabaseUserAndWait(ctx context.Context, deleteDatabaseUserOptions *clouddatabasesv5.DeleteDatabaseUserOptions, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.DeleteDatabaseUserResponse, task *clouddatabasesv5.Task, response *core.DetailedResponse, err error) {
+	result, response, err = c.DeleteDatabaseUserWithContext(ctx, deleteDatabaseUserOptions)
+	if err != nil || result == nil {
+		return
+	}
+	task = result.Task
+	if task != nil && task.ID != nil {
+		task, err = c.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+	}
+	return
+}
+
+// UpdateDatabaseConfigurationAndWait invokes UpdateDatabaseConfigurationWithContext and then WaitForTask, like the service client does.
+func (c *Client) UpdateDatabaseConfigurationAndWait(ctx context.Context, updateDatabaseConfigurationOptions *clouddatabasesv5.UpdateDatabaseConfigurationOptions, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.UpdateDatabaseConfigurationResponse, task *clouddatabasesv5.Task, response *core.DetailedResponse, err error) {
+	result, response, err = c.UpdateDatabaseConfigurationWithContext(ctx, updateDatabaseConfigurationOptions)
+	if err != nil || result == nil {
+		return
+	}
+	task = result.Task
+	if task != nil && task.ID != nil {
+		task, err = c.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+	}
+	return
+}
+
+// ResyncReplicaAndWait invokes ResyncReplicaWithContext and then WaitForTask, like the service client does.
+func (c *Client) ResyncReplicaAndWait(ctx context.Context, resyncReplicaOptions *clouddatabasesv5.ResyncReplicaOptions, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.ResyncReplicaResponse, task *clouddatabasesv5.Task, response *core.DetailedResponse, err error) {
+	result, response, err = c.ResyncReplicaWithContext(ctx, resyncReplicaOptions)
+	if err != nil || result == nil {
+		return
+	}
+	task = result.Task
+	if task != nil && task.ID != nil {
+		task, err = c.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+	}
+	return
+}
+
+// PromoteReadOnlyReplicaAndWait invokes PromoteReadOnlyReplicaWithContext and then WaitForTask, like the service client does.
+func (c *Client) PromoteReadOnlyReplicaAndWait(ctx context.Context, promoteReadOnlyReplicaOptions *clouddatabasesv5.PromoteReadOnlyReplicaOptions, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.PromoteReadOnlyReplicaResponse, task *clouddatabasesv5.Task, response *core.DetailedResponse, err error) {
+	result, response, err = c.PromoteReadOnlyReplicaWithContext(ctx, promoteReadOnlyReplicaOptions)
+	if err != nil || result == nil {
+		return
+	}
+	task = result.Task
+	if task != nil && task.ID != nil {
+		task, err = c.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+	}
+	return
+}
+
+// StartOndemandBackupAndWait invokes StartOndemandBackupWithContext and then WaitForTask, like the service client does.
+func (c *Client) StartOndemandBackupAndWait(ctx context.Context, startOndemandBackupOptions *clouddatabasesv5.StartOndemandBackupOptions, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.StartOndemandBackupResponse, task *clouddatabasesv5.Task, response *core.DetailedResponse, err error) {
+	result, response, err = c.StartOndemandBackupWithContext(ctx, startOndemandBackupOptions)
+	if err != nil || result == nil {
+		return
+	}
+	task = result.Task
+	if task != nil && task.ID != nil {
+		task, err = c.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+	}
+	return
+}
+
+// SetDeploymentScalingGroupAndWait invokes SetDeploymentScalingGroupWithContext and then WaitForTask, like the service client does.
+func (c *Client) SetDeploymentScalingGroupAndWait(ctx context.Context, setDeploymentScalingGroupOptions *clouddatabasesv5.SetDeploymentScalingGroupOptions, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.SetDeploymentScalingGroupResponse, task *clouddatabasesv5.Task, response *core.DetailedResponse, err error) {
+	result, response, err = c.SetDeploymentScalingGroupWithContext(ctx, setDeploymentScalingGroupOptions)
+	if err != nil || result == nil {
+		return
+	}
+	task = result.Task
+	if task != nil && task.ID != nil {
+		task, err = c.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+	}
+	return
+}
+
+// SetAutoscalingConditionsAndWait invokes SetAutoscalingConditionsWithContext and then WaitForTask, like the service client does.
+func (c *Client) SetAutoscalingConditionsAndWait(ctx context.Context, setAutoscalingConditionsOptions *clouddatabasesv5.SetAutoscalingConditionsOptions, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.SetAutoscalingConditionsResponse, task *clouddatabasesv5.Task, response *core.DetailedResponse, err error) {
+	result, response, err = c.SetAutoscalingConditionsWithContext(ctx, setAutoscalingConditionsOptions)
+	if err != nil || result == nil {
+		return
+	}
+	task = result.Task
+	if task != nil && task.ID != nil {
+		task, err = c.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+	}
+	return
+}
+
+// KillConnectionsAndWait invokes KillConnectionsWithContext and then WaitForTask, like the service client does.
+func (c *Client) KillConnectionsAndWait(ctx context.Context, killConnectionsOptions *clouddatabasesv5.KillConnectionsOptions, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.KillConnectionsResponse, task *clouddatabasesv5.Task, response *core.DetailedResponse, err error) {
+	result, response, err = c.KillConnectionsWithContext(ctx, killConnectionsOptions)
+	if err != nil || result == nil {
+		return
+	}
+	task = result.Task
+	if task != nil && task.ID != nil {
+		task, err = c.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+	}
+	return
+}
+
+// CreateLogicalReplicationSlotAndWait invokes CreateLogicalReplicationSlotWithContext and then WaitForTask, like the service client does.
+func (c *Client) CreateLogicalReplicationSlotAndWait(ctx context.Context, createLogicalReplicationSlotOptions *clouddatabasesv5.CreateLogicalReplicationSlotOptions, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.CreateLogicalReplicationSlotResponse, task *clouddatabasesv5.Task, response *core.DetailedResponse, err error) {
+	result, response, err = c.CreateLogicalReplicationSlotWithContext(ctx, createLogicalReplicationSlotOptions)
+	if err != nil || result == nil {
+		return
+	}
+	task = result.Task
+	if task != nil && task.ID != nil {
+		task, err = c.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+	}
+	return
+}
+
+// DeleteLogicalReplicationSlotAndWait invokes DeleteLogicalReplicationSlotWithContext and then WaitForTask, like the service client does.
+func (c *Client) DeleteLogicalReplicationSlotAndWait(ctx context.Context, deleteLogicalReplicationSlotOptions *clouddatabasesv5.DeleteLogicalReplicationSlotOptions, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.DeleteLogicalReplicationSlotResponse, task *clouddatabasesv5.Task, response *core.DetailedResponse, err error) {
+	result, response, err = c.DeleteLogicalReplicationSlotWithContext(ctx, deleteLogicalReplicationSlotOptions)
+	if err != nil || result == nil {
+		return
+	}
+	task = result.Task
+	if task != nil && task.ID != nil {
+		task, err = c.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+	}
+	return
+}
+
+// SetAllowlistAndWait invokes SetAllowlistWithContext and then WaitForTask, like the service client does.
+func (c *Client) SetAllowlistAndWait(ctx context.Context, setAllowlistOptions *clouddatabasesv5.SetAllowlistOptions, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.SetAllowlistResponse, task *clouddatabasesv5.Task, response *core.DetailedResponse, err error) {
+	result, response, err = c.SetAllowlistWithContext(ctx, setAllowlistOptions)
+	if err != nil || result == nil {
+		return
+	}
+	task = result.Task
+	if task != nil && task.ID != nil {
+		task, err = c.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+	}
+	return
+}
+
+// AddAllowlistEntryAndWait invokes AddAllowlistEntryWithContext and then WaitForTask, like the service client does.
+func (c *Client) AddAllowlistEntryAndWait(ctx context.Context, addAllowlistEntryOptions *clouddatabasesv5.AddAllowlistEntryOptions, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.AddAllowlistEntryResponse, task *clouddatabasesv5.Task, response *core.DetailedResponse, err error) {
+	result, response, err = c.AddAllowlistEntryWithContext(ctx, addAllowlistEntryOptions)
+	if err != nil || result == nil {
+		return
+	}
+	task = result.Task
+	if task != nil && task.ID != nil {
+		task, err = c.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+	}
+	return
+}
+
+// DeleteAllowlistEntryAndWait invokes DeleteAllowlistEntryWithContext and then WaitForTask, like the service client does.
+func (c *Client) DeleteAllowlistEntryAndWait(ctx context.Context, deleteAllowlistEntryOptions *clouddatabasesv5.DeleteAllowlistEntryOptions, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.DeleteAllowlistEntryResponse, task *clouddatabasesv5.Task, response *core.DetailedResponse, err error) {
+	result, response, err = c.DeleteAllowlistEntryWithContext(ctx, deleteAllowlistEntryOptions)
+	if err != nil || result == nil {
+		return
+	}
+	task = result.Task
+	if task != nil && task.ID != nil {
+		task, err = c.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+	}
+	return
+}
+
+// SetDatabaseInplaceVersionUpgradeAndWait invokes SetDatabaseInplaceVersionUpgradeWithContext and then WaitForTask, like the service client does.
+func (c *Client) SetDatabaseInplaceVersionUpgradeAndWait(ctx context.Context, setDatabaseInplaceVersionUpgradeOptions *clouddatabasesv5.SetDatabaseInplaceVersionUpgradeOptions, waitForTaskOptions *clouddatabasesv5.WaitForTaskOptions) (result *clouddatabasesv5.SetDatabaseInplaceVersionUpgradeResponse, task *clouddatabasesv5.Task, response *core.DetailedResponse, err error) {
+	result, response, err = c.SetDatabaseInplaceVersionUpgradeWithContext(ctx, setDatabaseInplaceVersionUpgradeOptions)
+	if err != nil || result == nil {
+		return
+	}
+	task = result.Task
+	if task != nil && task.ID != nil {
+		task, err = c.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+	}
+	return
+}
@@ -0,0 +1,100 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stub
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// takeBackup stands in for application code written against the interface.
+func takeBackup(api clouddatabasesv5.CloudDatabasesAPI, id string) (*clouddatabasesv5.Task, error) {
+	options := (*clouddatabasesv5.CloudDatabasesV5)(nil).NewStartOndemandBackupOptions(id)
+	_, task, _, err := api.StartOndemandBackupAndWait(context.Background(), options, nil)
+	return task, err
+}
+
+func TestDefaultTaskFlow(t *testing.T) {
+	client := NewClient()
+
+	task, err := takeBackup(client, "deployment")
+	require.Nil(t, err)
+	assert.Equal(t, "stub-task-1", *task.ID)
+	assert.Equal(t, clouddatabasesv5.TaskResourceTypeBackupConst, *task.ResourceType)
+	assert.Equal(t, clouddatabasesv5.TaskStatusCompletedConst, *task.Status)
+
+	calls := client.Calls()
+	require.Len(t, calls, 2)
+	assert.Equal(t, "StartOndemandBackup", calls[0].Operation)
+	assert.Equal(t, "deployment", *calls[0].Options.(*clouddatabasesv5.StartOndemandBackupOptions).ID)
+	assert.Equal(t, "WaitForTask", calls[1].Operation)
+	assert.Equal(t, "stub-task-1", calls[1].Options)
+
+	client.Reset()
+	assert.Empty(t, client.Calls())
+}
+
+func TestScriptedResults(t *testing.T) {
+	client := NewClient()
+	backup := &clouddatabasesv5.Backup{ID: core.StringPtr("backup-id"), Status: core.StringPtr(clouddatabasesv5.BackupStatusCompletedConst)}
+	client.GetBackupInfoFunc = Return[clouddatabasesv5.GetBackupInfoOptions](&clouddatabasesv5.GetBackupInfoResponse{Backup: backup})
+
+	result, response, err := client.GetBackupInfo(&clouddatabasesv5.GetBackupInfoOptions{BackupID: core.StringPtr("backup-id")})
+	require.Nil(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+	assert.Equal(t, backup, result.Backup)
+
+	conflict := errors.New("conflict")
+	client.SetAllowlistFunc = Fail[clouddatabasesv5.SetAllowlistOptions, clouddatabasesv5.SetAllowlistResponse](412, conflict)
+	_, task, response, err := client.SetAllowlistAndWait(context.Background(), &clouddatabasesv5.SetAllowlistOptions{}, nil)
+	assert.Equal(t, conflict, err)
+	assert.Equal(t, 412, response.StatusCode)
+	assert.Nil(t, task)
+	assert.Len(t, client.CallsTo("SetAllowlist"), 1)
+	assert.Empty(t, client.CallsTo("WaitForTask"))
+}
+
+func TestScriptedTaskFailure(t *testing.T) {
+	client := NewClient()
+	client.WaitForTaskFunc = func(ctx context.Context, taskID string, _ *clouddatabasesv5.WaitForTaskOptions) (*clouddatabasesv5.Task, error) {
+		return &clouddatabasesv5.Task{ID: core.StringPtr(taskID), Status: core.StringPtr(clouddatabasesv5.TaskStatusFailedConst)}, clouddatabasesv5.ErrTaskFailed
+	}
+
+	task, err := takeBackup(client, "deployment")
+	assert.Equal(t, clouddatabasesv5.ErrTaskFailed, err)
+	assert.Equal(t, clouddatabasesv5.TaskStatusFailedConst, *task.Status)
+}
+
+func TestOperationsWithoutTasks(t *testing.T) {
+	client := NewClient()
+
+	result, response, err := client.GetConnection(&clouddatabasesv5.GetConnectionOptions{})
+	require.Nil(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, result, response.Result)
+
+	updated, task, _, err := client.UpdateUserAndWait(context.Background(), &clouddatabasesv5.UpdateUserOptions{}, nil)
+	require.Nil(t, err)
+	assert.NotNil(t, updated.Task)
+	assert.Equal(t, clouddatabasesv5.TaskStatusCompletedConst, *task.Status)
+}
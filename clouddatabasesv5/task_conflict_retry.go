@@ -0,0 +1,144 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Default retry behavior used by GuardedClient.EnableTaskConflictRetries when the corresponding TaskConflictRetryOptions field is
+// not set.
+const (
+	DefaultTaskConflictMaxRetries        = 3
+	DefaultTaskConflictBackoff           = time.Second
+	DefaultTaskConflictMaxBackoff        = 30 * time.Second
+	DefaultTaskConflictBackoffMultiplier = 2
+)
+
+// TaskConflictRetryOptions : The GuardedClient.EnableTaskConflictRetries options.
+type TaskConflictRetryOptions struct {
+	// How many times an operation is retried. Defaults to DefaultTaskConflictMaxRetries.
+	MaxRetries int
+
+	// Delay before the first retry, once the running tasks have ended. Defaults to DefaultTaskConflictBackoff.
+	Backoff time.Duration
+
+	// Upper bound for the delay before a retry. Defaults to DefaultTaskConflictMaxBackoff.
+	MaxBackoff time.Duration
+
+	// Factor by which the delay grows after each retry. Defaults to DefaultTaskConflictBackoffMultiplier; values
+	// below 1 are treated as 1 (constant delay).
+	BackoffMultiplier float64
+
+	// The options used to wait for the running tasks of the deployment. See WaitForTask.
+	WaitForTaskOptions *WaitForTaskOptions
+}
+
+// EnableTaskConflictRetries makes the operations that start a task on a deployment retry when the service refuses
+// them because another task is running on the deployment (see ErrTaskConflict). Before each retry, the running tasks
+// of the deployment are found with ListDeploymentTasks and waited for, then the operation waits for the backoff
+// delay. Unlike EnableRetries, which only covers transport errors and 429/5xx responses, the operation is sent again
+// as a whole.
+func (guarded *GuardedClient) EnableTaskConflictRetries(options *TaskConflictRetryOptions) {
+	if options == nil {
+		options = &TaskConflictRetryOptions{}
+	}
+	retryOptions := *options
+	if retryOptions.MaxRetries <= 0 {
+		retryOptions.MaxRetries = DefaultTaskConflictMaxRetries
+	}
+	if retryOptions.Backoff <= 0 {
+		retryOptions.Backoff = DefaultTaskConflictBackoff
+	}
+	if retryOptions.MaxBackoff <= 0 {
+		retryOptions.MaxBackoff = DefaultTaskConflictMaxBackoff
+	}
+	if retryOptions.BackoffMultiplier == 0 {
+		retryOptions.BackoffMultiplier = DefaultTaskConflictBackoffMultiplier
+	} else if retryOptions.BackoffMultiplier < 1 {
+		retryOptions.BackoffMultiplier = 1
+	}
+	guarded.taskConflictRetryOptions = &retryOptions
+}
+
+// DisableTaskConflictRetries stops retrying the operations refused because of a running task.
+func (guarded *GuardedClient) DisableTaskConflictRetries() {
+	guarded.taskConflictRetryOptions = nil
+}
+
+// retryTaskConflicts invokes the operation until it is not refused because of a running task on the deployment or it
+// has been retried MaxRetries times.
+func retryTaskConflicts[T any](ctx context.Context, guarded *GuardedClient, id string, operation func() (T, *core.DetailedResponse, error)) (result T, response *core.DetailedResponse, err error) {
+	options := guarded.taskConflictRetryOptions
+
+	backoff := options.Backoff
+	for retry := 0; ; retry++ {
+		result, response, err = operation()
+		if err == nil || !errors.Is(err, ErrTaskConflict) || retry == options.MaxRetries {
+			return
+		}
+
+		waitErr := guarded.waitForRunningTasks(ctx, id, options.WaitForTaskOptions)
+		if waitErr == nil {
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				waitErr = ctx.Err()
+			case <-timer.C:
+			}
+		}
+		if waitErr != nil {
+			err = core.SDKErrorf(waitErr, "", "task-conflict-retry-error", common.GetComponentInfo())
+			return
+		}
+
+		backoff = time.Duration(float64(backoff) * options.BackoffMultiplier)
+		if backoff > options.MaxBackoff {
+			backoff = options.MaxBackoff
+		}
+	}
+}
+
+// waitForRunningTasks waits for every queued or running task of the deployment to reach a terminal status. It only
+// returns an error if the tasks cannot be listed or the context ends.
+func (guarded *GuardedClient) waitForRunningTasks(ctx context.Context, id string, waitForTaskOptions *WaitForTaskOptions) error {
+	tasks, _, err := guarded.ListDeploymentTasksWithContext(ctx, (*CloudDatabasesV5)(nil).NewListDeploymentTasksOptions(id))
+	if err != nil {
+		return err
+	}
+	if tasks == nil {
+		return nil
+	}
+	for i := range tasks.Tasks {
+		task := &tasks.Tasks[i]
+		if task.ID == nil || IsTaskTerminal(task) {
+			continue
+		}
+		// The task failing does not prevent the operation from being retried.
+		_, _ = guarded.WaitForTask(ctx, *task.ID, waitForTaskOptions)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5/fake"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Task conflict retries`, func() {
+	const deploymentID = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/abc123:d1a2b3c4-0000-4000-8000-000000000001::"
+
+	updateConfiguration := func(api clouddatabasesv5.CloudDatabasesAPI) error {
+		updateDatabaseConfigurationOptions := (*clouddatabasesv5.CloudDatabasesV5)(nil).NewUpdateDatabaseConfigurationOptions(deploymentID)
+		updateDatabaseConfigurationOptions.SetConfiguration(&clouddatabasesv5.ConfigurationPgConfiguration{MaxConnections: core.Int64Ptr(200)})
+		_, _, err := api.UpdateDatabaseConfigurationWithContext(context.Background(), updateDatabaseConfigurationOptions)
+		return err
+	}
+
+	It(`Wait for the running task and retry`, func() {
+		clock := fake.NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		server := fake.NewServer(&fake.Options{Clock: clock, RunningDuration: time.Minute, RejectConcurrentTasks: true})
+		defer server.Close()
+		server.AddDeployment(clouddatabasesv5.Deployment{ID: core.StringPtr(deploymentID), Type: core.StringPtr("postgresql")})
+
+		cloudDatabasesService, err := clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		Expect(updateConfiguration(cloudDatabasesService)).To(BeNil())
+
+		guarded := clouddatabasesv5.NewGuardedClient(cloudDatabasesService)
+		guarded.EnableTaskConflictRetries(&clouddatabasesv5.TaskConflictRetryOptions{
+			Backoff:            time.Millisecond,
+			WaitForTaskOptions: cloudDatabasesService.NewWaitForTaskOptions().SetPollInterval(time.Millisecond),
+		})
+		done := make(chan error, 1)
+		go func() {
+			done <- updateConfiguration(guarded)
+		}()
+		Consistently(done, 50*time.Millisecond).ShouldNot(Receive())
+
+		clock.Advance(2 * time.Minute)
+		Eventually(done).Should(Receive(BeNil()))
+		Expect(server.Tasks(deploymentID)).To(HaveLen(2))
+	})
+	It(`Give up after the maximum number of retries`, func() {
+		var attempts int32
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			if req.Method == http.MethodGet {
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"tasks": []}`)
+				return
+			}
+			atomic.AddInt32(&attempts, 1)
+			res.WriteHeader(409)
+			fmt.Fprint(res, `{"errors": "A task is already running"}`)
+		}))
+		defer server.Close()
+
+		cloudDatabasesService, err := clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		guarded := clouddatabasesv5.NewGuardedClient(cloudDatabasesService)
+		guarded.EnableTaskConflictRetries(&clouddatabasesv5.TaskConflictRetryOptions{
+			MaxRetries: 2,
+			Backoff:    time.Millisecond,
+		})
+
+		err = updateConfiguration(guarded)
+		Expect(errors.Is(err, clouddatabasesv5.ErrTaskConflict)).To(BeTrue())
+		Expect(atomic.LoadInt32(&attempts)).To(Equal(int32(3)))
+
+		guarded.DisableTaskConflictRetries()
+		Expect(errors.Is(updateConfiguration(guarded), clouddatabasesv5.ErrTaskConflict)).To(BeTrue())
+		Expect(atomic.LoadInt32(&attempts)).To(Equal(int32(4)))
+	})
+})
@@ -0,0 +1,291 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ErrTaskQueueFull is wrapped by the error returned when an operation cannot be queued because MaxQueueDepth
+// operations are already waiting for the same deployment.
+var ErrTaskQueueFull = errors.New("too many operations queued for the deployment")
+
+// TaskSerializationOptions : The GuardedClient.EnableTaskSerialization options.
+type TaskSerializationOptions struct {
+	// The largest number of operations that can wait for their turn on a deployment; further operations fail with
+	// ErrTaskQueueFull. Zero means no limit.
+	MaxQueueDepth int
+
+	// The options used to wait for the task of the previous operation, and for the task of the last operation of an
+	// idle deployment before its queue is dropped. See WaitForTask.
+	WaitForTaskOptions *WaitForTaskOptions
+}
+
+// EnableTaskSerialization makes the operations that start a task on a deployment (CreateDatabaseUser, SetAllowlist,
+// UpdateDatabaseConfiguration, ...) take turns, per deployment ID, within this process: each operation waits for the
+// task started by the previous one to reach a terminal status before sending its own request. Cancelling the context
+// of a waiting operation removes it from the queue.
+//
+// Only the operations made through this GuardedClient take turns; tasks started by other clients or processes are
+// not waited for.
+func (guarded *GuardedClient) EnableTaskSerialization(options *TaskSerializationOptions) {
+	if options == nil {
+		options = &TaskSerializationOptions{}
+	}
+	guarded.taskSerializer = &taskSerializer{
+		options: *options,
+		queues:  map[string]*deploymentQueue{},
+	}
+}
+
+// DisableTaskSerialization stops queueing the operations that start a task. Operations already queued still take
+// their turn.
+func (guarded *GuardedClient) DisableTaskSerialization() {
+	guarded.taskSerializer = nil
+}
+
+// taskSerializer holds the queue of each deployment.
+type taskSerializer struct {
+	options TaskSerializationOptions
+
+	mu     sync.Mutex
+	queues map[string]*deploymentQueue
+}
+
+// deploymentQueue : The operations of one deployment.
+// The operation holding the turn token is the only one sending a request for the deployment.
+type deploymentQueue struct {
+	turn       chan struct{}
+	held       bool
+	waiting    int
+	lastTaskID string
+}
+
+// removeIfIdle deletes the queue of the deployment when no operation holds or waits for its turn. If the task started
+// by the last operation may still be running, the queue is deleted once the task reaches a terminal status instead.
+// The caller holds serializer.mu.
+func (serializer *taskSerializer) removeIfIdle(guarded *GuardedClient, id string, queue *deploymentQueue) {
+	if serializer.queues[id] != queue || queue.held || queue.waiting > 0 {
+		return
+	}
+	if queue.lastTaskID == "" {
+		delete(serializer.queues, id)
+		return
+	}
+	go serializer.forgetTask(guarded, id, queue, queue.lastTaskID)
+}
+
+// forgetTask waits for the task started by the last operation of an idle queue, then deletes the queue if it is
+// still idle.
+func (serializer *taskSerializer) forgetTask(guarded *GuardedClient, id string, queue *deploymentQueue, taskID string) {
+	_, _ = guarded.WaitForTask(context.Background(), taskID, serializer.options.WaitForTaskOptions)
+
+	serializer.mu.Lock()
+	defer serializer.mu.Unlock()
+	if queue.lastTaskID == taskID {
+		queue.lastTaskID = ""
+		serializer.removeIfIdle(guarded, id, queue)
+	}
+}
+
+// taskResult is implemented by the results of the operations that start a task.
+type taskResult interface {
+	resultTask() *Task
+}
+
+// acquireDeployment waits for the turn of the operation on the deployment when task serialization is enabled. The
+// returned function must be called with the result of the operation once its request is done.
+func (guarded *GuardedClient) acquireDeployment(ctx context.Context, id string) (release func(result taskResult), err error) {
+	serializer := guarded.taskSerializer
+	if serializer == nil {
+		return func(taskResult) {}, nil
+	}
+
+	serializer.mu.Lock()
+	queue, ok := serializer.queues[id]
+	if !ok {
+		queue = &deploymentQueue{turn: make(chan struct{}, 1)}
+		serializer.queues[id] = queue
+	}
+	if serializer.options.MaxQueueDepth > 0 && queue.waiting >= serializer.options.MaxQueueDepth {
+		serializer.mu.Unlock()
+		err = fmt.Errorf("%w: %d operations are waiting for '%s'", ErrTaskQueueFull, serializer.options.MaxQueueDepth, id)
+		return nil, core.SDKErrorf(err, "", "task-queue-full", common.GetComponentInfo())
+	}
+	queue.waiting++
+	serializer.mu.Unlock()
+
+	select {
+	case queue.turn <- struct{}{}:
+	case <-ctx.Done():
+		serializer.mu.Lock()
+		queue.waiting--
+		serializer.removeIfIdle(guarded, id, queue)
+		serializer.mu.Unlock()
+		return nil, core.SDKErrorf(ctx.Err(), "", "task-queue-canceled", common.GetComponentInfo())
+	}
+
+	serializer.mu.Lock()
+	queue.waiting--
+	queue.held = true
+	lastTaskID := queue.lastTaskID
+	serializer.mu.Unlock()
+
+	release = func(result taskResult) {
+		serializer.mu.Lock()
+		defer serializer.mu.Unlock()
+		queue.lastTaskID = ""
+		if task := result.resultTask(); task != nil && task.ID != nil {
+			queue.lastTaskID = *task.ID
+		}
+		queue.held = false
+		<-queue.turn
+		serializer.removeIfIdle(guarded, id, queue)
+	}
+
+	if lastTaskID != "" {
+		// The previous task failing, or being gone, does not prevent this operation from running.
+		_, _ = guarded.WaitForTask(ctx, lastTaskID, serializer.options.WaitForTaskOptions)
+		if ctx.Err() != nil {
+			serializer.mu.Lock()
+			queue.held = false
+			<-queue.turn
+			serializer.removeIfIdle(guarded, id, queue)
+			serializer.mu.Unlock()
+			return nil, core.SDKErrorf(ctx.Err(), "", "task-queue-canceled", common.GetComponentInfo())
+		}
+	}
+	return release, nil
+}
+
+func (result *CreateDatabaseUserResponse) resultTask() *Task {
+	if result == nil {
+		return nil
+	}
+	return result.Task
+}
+
+func (result *UpdateUserResponse) resultTask() *Task {
+	if result == nil {
+		return nil
+	}
+	return result.Task
+}
+
+func (result *DeleteDatabaseUserResponse) resultTask() *Task {
+	if result == nil {
+		return nil
+	}
+	return result.Task
+}
+
+func (result *UpdateDatabaseConfigurationResponse) resultTask() *Task {
+	if result == nil {
+		return nil
+	}
+	return result.Task
+}
+
+func (result *ResyncReplicaResponse) resultTask() *Task {
+	if result == nil {
+		return nil
+	}
+	return result.Task
+}
+
+func (result *PromoteReadOnlyReplicaResponse) resultTask() *Task {
+	if result == nil {
+		return nil
+	}
+	return result.Task
+}
+
+func (result *StartOndemandBackupResponse) resultTask() *Task {
+	if result == nil {
+		return nil
+	}
+	return result.Task
+}
+
+func (result *SetDeploymentScalingGroupResponse) resultTask() *Task {
+	if result == nil {
+		return nil
+	}
+	return result.Task
+}
+
+func (result *SetAutoscalingConditionsResponse) resultTask() *Task {
+	if result == nil {
+		return nil
+	}
+	return result.Task
+}
+
+func (result *KillConnectionsResponse) resultTask() *Task {
+	if result == nil {
+		return nil
+	}
+	return result.Task
+}
+
+func (result *CreateLogicalReplicationSlotResponse) resultTask() *Task {
+	if result == nil {
+		return nil
+	}
+	return result.Task
+}
+
+func (result *DeleteLogicalReplicationSlotResponse) resultTask() *Task {
+	if result == nil {
+		return nil
+	}
+	return result.Task
+}
+
+func (result *SetAllowlistResponse) resultTask() *Task {
+	if result == nil {
+		return nil
+	}
+	return result.Task
+}
+
+func (result *AddAllowlistEntryResponse) resultTask() *Task {
+	if result == nil {
+		return nil
+	}
+	return result.Task
+}
+
+func (result *DeleteAllowlistEntryResponse) resultTask() *Task {
+	if result == nil {
+		return nil
+	}
+	return result.Task
+}
+
+func (result *SetDatabaseInplaceVersionUpgradeResponse) resultTask() *Task {
+	if result == nil {
+		return nil
+	}
+	return result.Task
+}
@@ -0,0 +1,142 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5/fake"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Task serialization`, func() {
+	const deploymentID = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/abc123:d1a2b3c4-0000-4000-8000-000000000001::"
+
+	var server *fake.Server
+	var clock *fake.ManualClock
+	var cloudDatabasesService *clouddatabasesv5.CloudDatabasesV5
+	var guarded *clouddatabasesv5.GuardedClient
+
+	vpn := clouddatabasesv5.AllowlistEntry{Address: core.StringPtr("10.0.0.0/8"), Description: core.StringPtr("vpn")}
+
+	setAllowlist := func(ctx context.Context) error {
+		setAllowlistOptions := cloudDatabasesService.NewSetAllowlistOptions(deploymentID)
+		setAllowlistOptions.SetIPAddresses([]clouddatabasesv5.AllowlistEntry{vpn})
+		_, _, err := guarded.SetAllowlistWithContext(ctx, setAllowlistOptions)
+		return err
+	}
+	updateConfiguration := func(ctx context.Context) error {
+		updateDatabaseConfigurationOptions := cloudDatabasesService.NewUpdateDatabaseConfigurationOptions(deploymentID)
+		updateDatabaseConfigurationOptions.SetConfiguration(&clouddatabasesv5.ConfigurationPgConfiguration{MaxConnections: core.Int64Ptr(200)})
+		_, _, err := guarded.UpdateDatabaseConfigurationWithContext(ctx, updateDatabaseConfigurationOptions)
+		return err
+	}
+	goUpdateConfiguration := func(ctx context.Context) chan error {
+		done := make(chan error, 1)
+		go func() {
+			done <- updateConfiguration(ctx)
+		}()
+		return done
+	}
+
+	BeforeEach(func() {
+		clock = fake.NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		server = fake.NewServer(&fake.Options{Clock: clock, RunningDuration: time.Minute, RejectConcurrentTasks: true})
+		server.AddDeployment(clouddatabasesv5.Deployment{ID: core.StringPtr(deploymentID), Type: core.StringPtr("postgresql")})
+
+		var err error
+		cloudDatabasesService, err = clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		guarded = clouddatabasesv5.NewGuardedClient(cloudDatabasesService)
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Conflict without serialization`, func() {
+		Expect(setAllowlist(context.Background())).To(BeNil())
+		Expect(errors.Is(updateConfiguration(context.Background()), clouddatabasesv5.ErrTaskConflict)).To(BeTrue())
+	})
+	It(`Wait for the previous task of the deployment`, func() {
+		guarded.EnableTaskSerialization(&clouddatabasesv5.TaskSerializationOptions{
+			WaitForTaskOptions: cloudDatabasesService.NewWaitForTaskOptions().SetPollInterval(time.Millisecond),
+		})
+		Expect(setAllowlist(context.Background())).To(BeNil())
+
+		done := goUpdateConfiguration(context.Background())
+		Consistently(done, 50*time.Millisecond).ShouldNot(Receive())
+
+		clock.Advance(2 * time.Minute)
+		Eventually(done).Should(Receive(BeNil()))
+		Expect(server.Tasks(deploymentID)).To(HaveLen(2))
+	})
+	It(`Limit the number of queued operations`, func() {
+		guarded.EnableTaskSerialization(&clouddatabasesv5.TaskSerializationOptions{
+			MaxQueueDepth:      1,
+			WaitForTaskOptions: cloudDatabasesService.NewWaitForTaskOptions().SetPollInterval(time.Millisecond),
+		})
+		Expect(setAllowlist(context.Background())).To(BeNil())
+
+		// One operation holds the turn while waiting for the task, one waits in the queue, and one is refused.
+		results := make(chan error, 3)
+		for i := 0; i < 3; i++ {
+			go func() {
+				results <- updateConfiguration(context.Background())
+			}()
+		}
+		var err error
+		Eventually(results).Should(Receive(&err))
+		Expect(errors.Is(err, clouddatabasesv5.ErrTaskQueueFull)).To(BeTrue())
+
+		for i := 0; i < 2; i++ {
+			Eventually(func() bool {
+				clock.Advance(time.Minute)
+				select {
+				case err = <-results:
+					return true
+				default:
+					return false
+				}
+			}).Should(BeTrue())
+			Expect(err).To(BeNil())
+		}
+	})
+	It(`Leave the queue when the context is canceled`, func() {
+		guarded.EnableTaskSerialization(&clouddatabasesv5.TaskSerializationOptions{
+			WaitForTaskOptions: cloudDatabasesService.NewWaitForTaskOptions().SetPollInterval(time.Millisecond),
+		})
+		Expect(setAllowlist(context.Background())).To(BeNil())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := goUpdateConfiguration(ctx)
+		cancel()
+		var err error
+		Eventually(done).Should(Receive(&err))
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+
+		guarded.DisableTaskSerialization()
+		Expect(errors.Is(updateConfiguration(context.Background()), clouddatabasesv5.ErrTaskConflict)).To(BeTrue())
+	})
+})
@@ -0,0 +1,252 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	common "github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Default polling behavior used by WaitForTask when the corresponding WaitForTaskOptions field is not set.
+const (
+	DefaultTaskPollInterval      = 2 * time.Second
+	DefaultTaskMaxPollInterval   = 30 * time.Second
+	DefaultTaskBackoffMultiplier = 1.5
+)
+
+var (
+	// ErrTaskFailed is reported (wrapped in a TaskError) when a task finishes with status "failed".
+	ErrTaskFailed = errors.New("task failed")
+
+	// ErrTaskExpired is reported (wrapped in a TaskError) when a task finishes with status "expired".
+	ErrTaskExpired = errors.New("task expired")
+)
+
+// TaskError : Describes a task that did not reach the "completed" status.
+// Err is ErrTaskFailed, ErrTaskExpired, or the error of the context that stopped the wait
+// (context.Canceled or context.DeadlineExceeded), so callers can tell the cases apart with errors.Is.
+type TaskError struct {
+	// ID of the task that was being waited on.
+	TaskID string
+
+	// The last observed state of the task, or nil if it was never retrieved.
+	Task *Task
+
+	// The reason the wait ended.
+	Err error
+}
+
+// Error returns a description of the task error.
+func (e *TaskError) Error() string {
+	if e.Task != nil && e.Task.Description != nil {
+		return fmt.Sprintf("task %s (%s): %s", e.TaskID, *e.Task.Description, e.Err.Error())
+	}
+	return fmt.Sprintf("task %s: %s", e.TaskID, e.Err.Error())
+}
+
+// Unwrap returns the reason the wait ended.
+func (e *TaskError) Unwrap() error {
+	return e.Err
+}
+
+// WaitForTaskOptions : The WaitForTask options.
+type WaitForTaskOptions struct {
+	// Delay between the first two polls. Defaults to DefaultTaskPollInterval.
+	PollInterval time.Duration
+
+	// Upper bound for the delay between polls. Defaults to DefaultTaskMaxPollInterval.
+	MaxPollInterval time.Duration
+
+	// Factor by which the delay grows after each poll. Defaults to DefaultTaskBackoffMultiplier;
+	// values below 1 are treated as 1 (constant interval).
+	BackoffMultiplier float64
+
+	// Maximum time to wait for the task. Zero means the wait is bounded only by the context.
+	Timeout time.Duration
+
+	// Invoked with the latest task state whenever its status or progress changes.
+	OnProgress func(task *Task)
+
+	// Allows users to set headers on the GetTask requests.
+	Headers map[string]string
+}
+
+// NewWaitForTaskOptions : Instantiate WaitForTaskOptions
+func (*CloudDatabasesV5) NewWaitForTaskOptions() *WaitForTaskOptions {
+	return &WaitForTaskOptions{}
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *WaitForTaskOptions) SetPollInterval(pollInterval time.Duration) *WaitForTaskOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetMaxPollInterval : Allow user to set MaxPollInterval
+func (_options *WaitForTaskOptions) SetMaxPollInterval(maxPollInterval time.Duration) *WaitForTaskOptions {
+	_options.MaxPollInterval = maxPollInterval
+	return _options
+}
+
+// SetBackoffMultiplier : Allow user to set BackoffMultiplier
+func (_options *WaitForTaskOptions) SetBackoffMultiplier(backoffMultiplier float64) *WaitForTaskOptions {
+	_options.BackoffMultiplier = backoffMultiplier
+	return _options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (_options *WaitForTaskOptions) SetTimeout(timeout time.Duration) *WaitForTaskOptions {
+	_options.Timeout = timeout
+	return _options
+}
+
+// SetOnProgress : Allow user to set OnProgress
+func (_options *WaitForTaskOptions) SetOnProgress(onProgress func(task *Task)) *WaitForTaskOptions {
+	_options.OnProgress = onProgress
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *WaitForTaskOptions) SetHeaders(param map[string]string) *WaitForTaskOptions {
+	options.Headers = param
+	return options
+}
+
+// IsTaskTerminal returns true if the task status is one that will not change anymore.
+func IsTaskTerminal(task *Task) bool {
+	if task == nil || task.Status == nil {
+		return false
+	}
+	switch *task.Status {
+	case TaskStatusCompletedConst, TaskStatusFailedConst, TaskStatusExpiredConst:
+		return true
+	}
+	return false
+}
+
+// WaitForTask : Wait for a task to finish
+// Polls GetTask until the task reaches a terminal status and returns its final state. A task that finishes with
+// status "failed" or "expired", or a wait that is stopped by the context or the configured timeout, results in an
+// error wrapping a *TaskError. When the service stops reporting the task (it is only retained for a limited
+// time after it finishes), the task is considered completed and its last observed state is returned.
+func (cloudDatabases *CloudDatabasesV5) WaitForTask(ctx context.Context, taskID string, waitForTaskOptions *WaitForTaskOptions) (result *Task, err error) {
+	if taskID == "" {
+		err = core.SDKErrorf(nil, "taskID cannot be empty", "missing-task-id", common.GetComponentInfo())
+		return
+	}
+	if waitForTaskOptions == nil {
+		waitForTaskOptions = &WaitForTaskOptions{}
+	}
+	if waitForTaskOptions.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, waitForTaskOptions.Timeout)
+		defer cancel()
+	}
+
+	interval := waitForTaskOptions.PollInterval
+	if interval <= 0 {
+		interval = DefaultTaskPollInterval
+	}
+	maxInterval := waitForTaskOptions.MaxPollInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultTaskMaxPollInterval
+	}
+	multiplier := waitForTaskOptions.BackoffMultiplier
+	if multiplier == 0 {
+		multiplier = DefaultTaskBackoffMultiplier
+	} else if multiplier < 1 {
+		multiplier = 1
+	}
+
+	getTaskOptions := cloudDatabases.NewGetTaskOptions(taskID)
+	getTaskOptions.Headers = waitForTaskOptions.Headers
+
+	var lastStatus string
+	var lastProgress int64 = -1
+	for {
+		var getTaskResponse *GetTaskResponse
+		getTaskResponse, _, err = cloudDatabases.GetTaskWithContext(ctx, getTaskOptions)
+		if err != nil {
+			if ctx.Err() != nil {
+				err = taskWaitError(taskID, result, ctx.Err())
+				return
+			}
+			err = core.RepurposeSDKProblem(err, "get-task-error")
+			return
+		}
+		if getTaskResponse == nil || getTaskResponse.Task == nil {
+			return
+		}
+		result = getTaskResponse.Task
+
+		status := core.StringNilMapper(result.Status)
+		progress := int64(-1)
+		if result.ProgressPercent != nil {
+			progress = *result.ProgressPercent
+		}
+		if waitForTaskOptions.OnProgress != nil && (status != lastStatus || progress != lastProgress) {
+			waitForTaskOptions.OnProgress(result)
+		}
+		lastStatus, lastProgress = status, progress
+
+		switch status {
+		case TaskStatusCompletedConst:
+			return
+		case TaskStatusFailedConst:
+			err = taskWaitError(taskID, result, ErrTaskFailed)
+			return
+		case TaskStatusExpiredConst:
+			err = taskWaitError(taskID, result, ErrTaskExpired)
+			return
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = taskWaitError(taskID, result, ctx.Err())
+			return
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * multiplier)
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+func taskWaitError(taskID string, task *Task, reason error) error {
+	discriminator := "task-wait-canceled"
+	switch reason {
+	case ErrTaskFailed:
+		discriminator = "task-failed"
+	case ErrTaskExpired:
+		discriminator = "task-expired"
+	}
+	taskErr := &TaskError{
+		TaskID: taskID,
+		Task:   task,
+		Err:    reason,
+	}
+	return core.SDKErrorf(taskErr, "", discriminator, common.GetComponentInfo())
+}
@@ -0,0 +1,177 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WaitForTask`, func() {
+	var testServer *httptest.Server
+	getTaskPath := "/tasks/testString"
+
+	// Serves the given task bodies in order, repeating the last one once the list is exhausted.
+	serveTasks := func(bodies ...string) {
+		calls := 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal(getTaskPath))
+			Expect(req.Method).To(Equal("GET"))
+
+			body := bodies[len(bodies)-1]
+			if calls < len(bodies) {
+				body = bodies[calls]
+			}
+			calls++
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, body)
+		}))
+	}
+	newService := func() *clouddatabasesv5.CloudDatabasesV5 {
+		cloudDatabasesService, serviceErr := clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(cloudDatabasesService).ToNot(BeNil())
+		return cloudDatabasesService
+	}
+	fastOptions := func() *clouddatabasesv5.WaitForTaskOptions {
+		return new(clouddatabasesv5.WaitForTaskOptions).
+			SetPollInterval(time.Millisecond).
+			SetMaxPollInterval(5 * time.Millisecond)
+	}
+
+	AfterEach(func() {
+		if testServer != nil {
+			testServer.Close()
+		}
+	})
+
+	It(`Waits until the task completes and reports progress`, func() {
+		serveTasks(
+			`{"task": {"id": "testString", "status": "queued", "progress_percent": 0}}`,
+			`{"task": {"id": "testString", "status": "running", "progress_percent": 40}}`,
+			`{"task": {"id": "testString", "status": "running", "progress_percent": 40}}`,
+			`{"task": {"id": "testString", "status": "completed", "progress_percent": 100}}`,
+		)
+		cloudDatabasesService := newService()
+
+		var progress []int64
+		options := fastOptions().SetOnProgress(func(task *clouddatabasesv5.Task) {
+			progress = append(progress, *task.ProgressPercent)
+		})
+		task, err := cloudDatabasesService.WaitForTask(context.Background(), "testString", options)
+		Expect(err).To(BeNil())
+		Expect(task).ToNot(BeNil())
+		Expect(*task.Status).To(Equal(clouddatabasesv5.TaskStatusCompletedConst))
+		Expect(progress).To(Equal([]int64{0, 40, 100}))
+	})
+	It(`Returns the last observed task when the service no longer reports it`, func() {
+		serveTasks(
+			`{"task": {"id": "testString", "status": "running", "progress_percent": 90}}`,
+			`{}`,
+		)
+		cloudDatabasesService := newService()
+
+		task, err := cloudDatabasesService.WaitForTask(context.Background(), "testString", fastOptions())
+		Expect(err).To(BeNil())
+		Expect(task).ToNot(BeNil())
+		Expect(*task.ProgressPercent).To(Equal(int64(90)))
+	})
+	It(`Returns a TaskError for a failed task`, func() {
+		serveTasks(`{"task": {"id": "testString", "description": "Updating allowlist", "status": "failed"}}`)
+		cloudDatabasesService := newService()
+
+		task, err := cloudDatabasesService.WaitForTask(context.Background(), "testString", fastOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, clouddatabasesv5.ErrTaskFailed)).To(BeTrue())
+		Expect(errors.Is(err, clouddatabasesv5.ErrTaskExpired)).To(BeFalse())
+		Expect(*task.Status).To(Equal(clouddatabasesv5.TaskStatusFailedConst))
+
+		var taskErr *clouddatabasesv5.TaskError
+		Expect(errors.As(err, &taskErr)).To(BeTrue())
+		Expect(taskErr.TaskID).To(Equal("testString"))
+		Expect(taskErr.Task).To(Equal(task))
+		Expect(err.Error()).To(ContainSubstring("Updating allowlist"))
+	})
+	It(`Returns a TaskError for an expired task`, func() {
+		serveTasks(`{"task": {"id": "testString", "status": "expired"}}`)
+		cloudDatabasesService := newService()
+
+		_, err := cloudDatabasesService.WaitForTask(context.Background(), "testString", fastOptions())
+		Expect(errors.Is(err, clouddatabasesv5.ErrTaskExpired)).To(BeTrue())
+		Expect(errors.Is(err, clouddatabasesv5.ErrTaskFailed)).To(BeFalse())
+	})
+	It(`Stops waiting when the timeout elapses`, func() {
+		serveTasks(`{"task": {"id": "testString", "status": "running"}}`)
+		cloudDatabasesService := newService()
+
+		task, err := cloudDatabasesService.WaitForTask(context.Background(), "testString", fastOptions().SetTimeout(30*time.Millisecond))
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(errors.Is(err, clouddatabasesv5.ErrTaskFailed)).To(BeFalse())
+		Expect(*task.Status).To(Equal(clouddatabasesv5.TaskStatusRunningConst))
+	})
+	It(`Stops waiting when the context is canceled`, func() {
+		serveTasks(`{"task": {"id": "testString", "status": "queued"}}`)
+		cloudDatabasesService := newService()
+
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		options := fastOptions().SetOnProgress(func(*clouddatabasesv5.Task) {
+			cancelFunc()
+		})
+		_, err := cloudDatabasesService.WaitForTask(ctx, "testString", options)
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+
+		var taskErr *clouddatabasesv5.TaskError
+		Expect(errors.As(err, &taskErr)).To(BeTrue())
+	})
+	It(`Returns request errors from GetTask`, func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.WriteHeader(404)
+		}))
+		cloudDatabasesService := newService()
+
+		task, err := cloudDatabasesService.WaitForTask(context.Background(), "testString", nil)
+		Expect(err).ToNot(BeNil())
+		Expect(task).To(BeNil())
+
+		var taskErr *clouddatabasesv5.TaskError
+		Expect(errors.As(err, &taskErr)).To(BeFalse())
+	})
+	It(`Rejects an empty task ID`, func() {
+		testServer = nil
+		cloudDatabasesService, _ := clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		_, err := cloudDatabasesService.WaitForTask(context.Background(), "", nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,217 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the Deployment.Type property.
+// Database type within this deployment.
+const (
+	DeploymentTypeEnterprisedbConst = "enterprisedb"
+	DeploymentTypeMysqlConst        = "mysql"
+	DeploymentTypePostgresqlConst   = "postgresql"
+	DeploymentTypeRabbitmqConst     = "rabbitmq"
+	DeploymentTypeRedisConst        = "redis"
+)
+
+// ErrConfigurationNotSupported is wrapped by the errors returned when a configuration, or some of its fields, cannot
+// be applied to the type of the deployment; use errors.Is to detect it.
+var ErrConfigurationNotSupported = errors.New("configuration not supported by the deployment type")
+
+// configurationTypes are the configuration models supported by each deployment type.
+var configurationTypes = map[string]reflect.Type{
+	DeploymentTypeEnterprisedbConst: reflect.TypeOf(ConfigurationPgConfiguration{}),
+	DeploymentTypeMysqlConst:        reflect.TypeOf(ConfigurationMySQLConfiguration{}),
+	DeploymentTypePostgresqlConst:   reflect.TypeOf(ConfigurationPgConfiguration{}),
+	DeploymentTypeRabbitmqConst:     reflect.TypeOf(ConfigurationRabbitMqConfiguration{}),
+	DeploymentTypeRedisConst:        reflect.TypeOf(ConfigurationRedisConfiguration{}),
+}
+
+// UpdatePostgresConfiguration : Change your database configuration
+// Change the configuration of a PostgreSQL or EnterpriseDB deployment. The type of the deployment is checked before
+// the configuration is sent; see CheckConfigurationType.
+func (cloudDatabases *CloudDatabasesV5) UpdatePostgresConfiguration(ctx context.Context, id string, configuration *ConfigurationPgConfiguration) (result *UpdateDatabaseConfigurationResponse, response *core.DetailedResponse, err error) {
+	return cloudDatabases.updateTypedConfiguration(ctx, id, configuration)
+}
+
+// UpdateMySQLConfiguration : Change your database configuration
+// Change the configuration of a MySQL deployment. The type of the deployment is checked before the configuration is
+// sent; see CheckConfigurationType.
+func (cloudDatabases *CloudDatabasesV5) UpdateMySQLConfiguration(ctx context.Context, id string, configuration *ConfigurationMySQLConfiguration) (result *UpdateDatabaseConfigurationResponse, response *core.DetailedResponse, err error) {
+	return cloudDatabases.updateTypedConfiguration(ctx, id, configuration)
+}
+
+// UpdateRedisConfiguration : Change your database configuration
+// Change the configuration of a Redis deployment. The type of the deployment is checked before the configuration is
+// sent; see CheckConfigurationType.
+func (cloudDatabases *CloudDatabasesV5) UpdateRedisConfiguration(ctx context.Context, id string, configuration *ConfigurationRedisConfiguration) (result *UpdateDatabaseConfigurationResponse, response *core.DetailedResponse, err error) {
+	return cloudDatabases.updateTypedConfiguration(ctx, id, configuration)
+}
+
+// UpdateRabbitMqConfiguration : Change your database configuration
+// Change the configuration of a RabbitMQ deployment. The type of the deployment is checked before the configuration
+// is sent; see CheckConfigurationType.
+func (cloudDatabases *CloudDatabasesV5) UpdateRabbitMqConfiguration(ctx context.Context, id string, configuration *ConfigurationRabbitMqConfiguration) (result *UpdateDatabaseConfigurationResponse, response *core.DetailedResponse, err error) {
+	return cloudDatabases.updateTypedConfiguration(ctx, id, configuration)
+}
+
+func (cloudDatabases *CloudDatabasesV5) updateTypedConfiguration(ctx context.Context, id string, configuration ConfigurationIntf) (result *UpdateDatabaseConfigurationResponse, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(configuration, "configuration cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	updateDatabaseConfigurationOptions := cloudDatabases.NewUpdateDatabaseConfigurationOptions(id)
+	updateDatabaseConfigurationOptions.SetConfiguration(configuration)
+	err = updateDatabaseConfigurationOptions.validateConfiguration()
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "configuration-validation-error")
+		return
+	}
+
+	deploymentType, err := cloudDatabases.deploymentType(ctx, id)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "deployment-type-error")
+		return
+	}
+	err = CheckConfigurationType(deploymentType, configuration)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "configuration-type-error")
+		return
+	}
+	return cloudDatabases.UpdateDatabaseConfigurationWithContext(ctx, updateDatabaseConfigurationOptions)
+}
+
+// CheckConfigurationType returns an error wrapping ErrConfigurationNotSupported if the configuration cannot be
+// applied to a deployment of the specified type: the deployment type has no configuration, the configuration is the
+// model of another type (for example a ConfigurationRedisConfiguration for a "postgresql" deployment), or it is a
+// Configuration that sets fields the type does not support.
+func CheckConfigurationType(deploymentType string, configuration ConfigurationIntf) error {
+	supportedType, ok := configurationTypes[deploymentType]
+	if !ok {
+		err := fmt.Errorf("%w: deployment type '%s' has no configuration", ErrConfigurationNotSupported, deploymentType)
+		return core.SDKErrorf(err, "", "unsupported-deployment-type", common.GetComponentInfo())
+	}
+
+	configurationType := reflect.TypeOf(configuration)
+	if configurationType.Kind() == reflect.Ptr {
+		configurationType = configurationType.Elem()
+	}
+	if configurationType != supportedType && configurationType != reflect.TypeOf(Configuration{}) {
+		err := fmt.Errorf("%w: %s cannot be applied to a %s deployment", ErrConfigurationNotSupported, configurationType.Name(), deploymentType)
+		return core.SDKErrorf(err, "", "unsupported-configuration-model", common.GetComponentInfo())
+	}
+
+	fields, err := configurationFields(configuration)
+	if err != nil {
+		return core.SDKErrorf(err, "", "configuration-fields-error", common.GetComponentInfo())
+	}
+	supportedFields := jsonFieldNames(supportedType)
+	var unsupported []string
+	for _, field := range fields {
+		if !supportedFields[field] {
+			unsupported = append(unsupported, field)
+		}
+	}
+	if len(unsupported) > 0 {
+		err := fmt.Errorf("%w: a %s deployment does not support %s", ErrConfigurationNotSupported, deploymentType, strings.Join(unsupported, ", "))
+		return core.SDKErrorf(err, "", "unsupported-configuration-field", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// configurationFields returns the sorted names of the fields set in the configuration, as sent to the service.
+func configurationFields(configuration ConfigurationIntf) ([]string, error) {
+	buf, err := json.Marshal(configuration)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]json.RawMessage
+	err = json.Unmarshal(buf, &values)
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]string, 0, len(values))
+	for field := range values {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+// jsonFieldNames returns the JSON names of the fields of a model.
+func jsonFieldNames(modelType reflect.Type) map[string]bool {
+	names := make(map[string]bool, modelType.NumField())
+	for i := 0; i < modelType.NumField(); i++ {
+		name, _, _ := strings.Cut(modelType.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// deploymentTypeCache remembers the type of the deployments, which never changes.
+type deploymentTypeCache struct {
+	mu    sync.Mutex
+	types map[string]string
+}
+
+// deploymentType returns the type of the deployment, such as "postgresql", from GetDeploymentInfo. The type is
+// cached by the client and its clones.
+func (cloudDatabases *CloudDatabasesV5) deploymentType(ctx context.Context, id string) (string, error) {
+	cache := cloudDatabases.deploymentTypes
+	if cache != nil {
+		cache.mu.Lock()
+		deploymentType, ok := cache.types[id]
+		cache.mu.Unlock()
+		if ok {
+			return deploymentType, nil
+		}
+	}
+
+	result, _, err := cloudDatabases.GetDeploymentInfoWithContext(ctx, cloudDatabases.NewGetDeploymentInfoOptions(id))
+	if err != nil {
+		return "", err
+	}
+	if result.Deployment == nil || result.Deployment.Type == nil {
+		return "", core.SDKErrorf(nil, fmt.Sprintf("the type of deployment '%s' is unknown", id), "missing-deployment-type", common.GetComponentInfo())
+	}
+	deploymentType := *result.Deployment.Type
+
+	if cache != nil {
+		cache.mu.Lock()
+		if cache.types == nil {
+			cache.types = make(map[string]string)
+		}
+		cache.types[id] = deploymentType
+		cache.mu.Unlock()
+	}
+	return deploymentType, nil
+}
@@ -0,0 +1,107 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clouddatabasesv5_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Typed configuration`, func() {
+	const deploymentID = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/abc123:d1a2b3c4-0000-4000-8000-000000000001::"
+
+	var server *httptest.Server
+	var requests []string
+	var cloudDatabasesService *clouddatabasesv5.CloudDatabasesV5
+
+	BeforeEach(func() {
+		requests = nil
+		server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req.Method)
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			if req.Method == http.MethodGet {
+				fmt.Fprintf(res, `{"deployment": {"id": "%s", "type": "postgresql"}}`, deploymentID)
+				return
+			}
+			fmt.Fprint(res, `{"task": {"id": "task-id", "status": "queued"}}`)
+		}))
+
+		var err error
+		cloudDatabasesService, err = clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Update the configuration of the deployment type`, func() {
+		configuration := &clouddatabasesv5.ConfigurationPgConfiguration{MaxConnections: core.Int64Ptr(200)}
+		for i := 0; i < 2; i++ {
+			result, _, err := cloudDatabasesService.UpdatePostgresConfiguration(context.Background(), deploymentID, configuration)
+			Expect(err).To(BeNil())
+			Expect(*result.Task.ID).To(Equal("task-id"))
+		}
+		// The type of the deployment is only read once.
+		Expect(requests).To(Equal([]string{http.MethodGet, http.MethodPatch, http.MethodPatch}))
+	})
+	It(`Reject the configuration of another deployment type`, func() {
+		configuration := &clouddatabasesv5.ConfigurationRedisConfiguration{MaxmemoryPolicy: core.StringPtr("allkeys-lru")}
+		_, _, err := cloudDatabasesService.UpdateRedisConfiguration(context.Background(), deploymentID, configuration)
+		Expect(errors.Is(err, clouddatabasesv5.ErrConfigurationNotSupported)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("ConfigurationRedisConfiguration cannot be applied to a postgresql deployment"))
+		Expect(requests).To(Equal([]string{http.MethodGet}))
+	})
+	It(`Validate the configuration before sending it`, func() {
+		configuration := &clouddatabasesv5.ConfigurationPgConfiguration{SharedBuffers: core.Int64Ptr(8)}
+		_, _, err := cloudDatabasesService.UpdatePostgresConfiguration(context.Background(), deploymentID, configuration)
+		Expect(errors.Is(err, clouddatabasesv5.ErrValidation)).To(BeTrue())
+		Expect(requests).To(BeEmpty())
+	})
+	It(`Reject a nil configuration`, func() {
+		_, _, err := cloudDatabasesService.UpdateMySQLConfiguration(context.Background(), deploymentID, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(requests).To(BeEmpty())
+	})
+	It(`Check the fields of a configuration`, func() {
+		Expect(clouddatabasesv5.CheckConfigurationType("mysql", &clouddatabasesv5.Configuration{MaxConnections: core.Int64Ptr(200)})).To(BeNil())
+		Expect(clouddatabasesv5.CheckConfigurationType("enterprisedb", &clouddatabasesv5.ConfigurationPgConfiguration{WalLevel: core.StringPtr("logical")})).To(BeNil())
+
+		err := clouddatabasesv5.CheckConfigurationType("postgresql", &clouddatabasesv5.Configuration{
+			MaxConnections:  core.Int64Ptr(200),
+			MaxmemoryPolicy: core.StringPtr("allkeys-lru"),
+			Appendonly:      core.StringPtr("yes"),
+		})
+		Expect(errors.Is(err, clouddatabasesv5.ErrConfigurationNotSupported)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("a postgresql deployment does not support appendonly, maxmemory-policy"))
+
+		err = clouddatabasesv5.CheckConfigurationType("elasticsearch", &clouddatabasesv5.Configuration{})
+		Expect(errors.Is(err, clouddatabasesv5.ErrConfigurationNotSupported)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("'elasticsearch' has no configuration"))
+	})
+})
@@ -0,0 +1,221 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package users reconciles the database users of a Cloud Databases deployment with a spec of the users it should
+// have, declared in code or kept in a YAML or JSON file.
+//
+// LoadFile reads the spec, NewPlan compares it with the current users of the deployment, and Plan.Apply creates the
+// missing users, updates the Redis roles that differ and deletes the users the spec does not list, if their usernames
+// are in PlanOptions.Prune. The API cannot list the users of a deployment, so the current users are supplied by the
+// caller. In dry-run mode the plan is only printed:
+//
+//	desired, err := users.LoadFile("users.yaml")
+//	...
+//	current := []users.CurrentUser{{Username: "admin"}, {Username: "legacy"}}
+//	plan, err := users.NewPlan(deploymentID, current, desired, &users.PlanOptions{Prune: []string{"legacy"}})
+//	...
+//	tasks, err := plan.Apply(ctx, cloudDatabasesService, &users.ApplyOptions{DryRun: true, Output: os.Stdout})
+//
+// Passwords are only set when a user is created; use the rotation package to change them.
+package users
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5/internal/declarative"
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the User.Type property.
+const (
+	// A database user, created with a clouddatabasesv5.UserDatabaseUser.
+	TypeDatabase = "database"
+
+	// A MongoDB Enterprise Ops Manager user, created with a clouddatabasesv5.UserOpsManagerUser.
+	TypeOpsManager = "ops_manager"
+
+	// A Redis database user, created with a clouddatabasesv5.UserRedisDatabaseUser. Its API user type is "database".
+	TypeRedis = "redis"
+)
+
+// User : A user that the deployment should have.
+type User struct {
+	// Username of the user.
+	Username string `json:"username"`
+
+	// TypeDatabase, TypeOpsManager or TypeRedis. Defaults to TypeDatabase.
+	Type string `json:"type,omitempty"`
+
+	// The role of a Redis user, in Redis ACL syntax. The role is left as it is when empty.
+	Role string `json:"role,omitempty"`
+
+	// Where the password of the user comes from when the user is created.
+	Password PasswordSource `json:"password"`
+}
+
+// PasswordSource : Where the password of a user comes from. Exactly one field must be set.
+type PasswordSource struct {
+	// The password itself.
+	Value string `json:"value,omitempty"`
+
+	// The name of an environment variable holding the password.
+	Env string `json:"env,omitempty"`
+
+	// The path of a file holding the password. A trailing newline is ignored.
+	File string `json:"file,omitempty"`
+
+	// Generate a password for the policy of the user type. See clouddatabasesv5.GeneratePassword.
+	Generate bool `json:"generate,omitempty"`
+}
+
+// Format : The format of a users file.
+type Format = declarative.Format
+
+// Constants associated with the Format type.
+const (
+	// A YAML sequence of users, or a mapping with the users under "users".
+	FormatYAML = declarative.FormatYAML
+
+	// A JSON array of users, or an object with the users under "users".
+	FormatJSON = declarative.FormatJSON
+)
+
+// FormatForPath returns the format of a file from its extension: ".yaml" or ".yml", or ".json".
+func FormatForPath(path string) (Format, error) {
+	return declarative.FormatForPath(path, "users", FormatYAML, FormatJSON)
+}
+
+// LoadFile reads users from a file, in the format given by its extension. See Load.
+func LoadFile(path string) (users []User, err error) {
+	err = declarative.LoadFile(path, "users", []Format{FormatYAML, FormatJSON}, func(reader io.Reader, format Format) (err error) {
+		users, err = Load(reader, format)
+		return
+	})
+	return
+}
+
+// Load reads users in the specified format, with their fields named as in the User JSON tags:
+//
+//	users:
+//	  - username: app
+//	    password: {env: APP_PASSWORD}
+//	  - username: cache
+//	    type: redis
+//	    role: +@read ~cache:*
+//	    password: {generate: true}
+//
+// Unknown fields are an error, and the users are checked with Validate.
+func Load(reader io.Reader, format Format) ([]User, error) {
+	users := []User{}
+	err := declarative.Decode(reader, format, "users", "users", &users)
+	if err != nil {
+		return nil, err
+	}
+
+	err = Validate(users)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "invalid-users")
+	}
+	return users, nil
+}
+
+// Validate checks that every user has a username, a known type, a valid role only if it is a Redis user, and exactly
+// one password source, and that no two users share a username and an API user type. The returned error wraps
+// clouddatabasesv5.ErrValidation, or clouddatabasesv5.ErrInvalidRedisRole for a malformed role.
+func Validate(users []User) error {
+	seen := map[string]bool{}
+	for _, user := range users {
+		var reason string
+		sources := 0
+		for _, set := range []bool{user.Password.Value != "", user.Password.Env != "", user.Password.File != "", user.Password.Generate} {
+			if set {
+				sources++
+			}
+		}
+		switch {
+		case user.Username == "":
+			reason = "no username"
+		case user.Type != "" && user.Type != TypeDatabase && user.Type != TypeOpsManager && user.Type != TypeRedis:
+			reason = fmt.Sprintf("unknown type '%s'", user.Type)
+		case user.Role != "" && user.Type != TypeRedis:
+			reason = "a role is only supported for Redis users"
+		case sources != 1:
+			reason = "exactly one password source must be set"
+		case seen[user.apiType()+"/"+user.Username]:
+			reason = "duplicate user"
+		}
+		if reason != "" {
+			err := fmt.Errorf("%w: user '%s': %s", clouddatabasesv5.ErrValidation, user.Username, reason)
+			return core.SDKErrorf(err, "", "invalid-user", common.GetComponentInfo())
+		}
+		if user.Role != "" {
+			if err := clouddatabasesv5.ValidateRedisRole(user.Role); err != nil {
+				return core.RepurposeSDKProblem(fmt.Errorf("user '%s': %w", user.Username, err), "invalid-user-role")
+			}
+		}
+		seen[user.apiType()+"/"+user.Username] = true
+	}
+	return nil
+}
+
+// apiType returns the user type of the user in the API.
+func (user User) apiType() string {
+	if user.Type == TypeOpsManager {
+		return TypeOpsManager
+	}
+	return TypeDatabase
+}
+
+// resolvePassword returns the password of the user from its source, checked against the policy of its type.
+func (user User) resolvePassword() (password string, err error) {
+	policy := clouddatabasesv5.PasswordPolicyForUserType(user.apiType())
+	switch {
+	case user.Password.Generate:
+		password, err = clouddatabasesv5.GeneratePassword(policy)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "generate-password-error")
+		}
+		return
+	case user.Password.Env != "":
+		var ok bool
+		password, ok = os.LookupEnv(user.Password.Env)
+		if !ok || password == "" {
+			err = core.SDKErrorf(nil, fmt.Sprintf("the environment variable %s of the password of user '%s' is not set", user.Password.Env, user.Username), "password-env-error", common.GetComponentInfo())
+			return
+		}
+	case user.Password.File != "":
+		var data []byte
+		data, err = os.ReadFile(user.Password.File)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "password-file-error", common.GetComponentInfo())
+			return
+		}
+		password = strings.TrimRight(string(data), "\r\n")
+	default:
+		password = user.Password.Value
+	}
+
+	err = policy.Validate(password)
+	if err != nil {
+		err = core.SDKErrorf(fmt.Errorf("user '%s': %w", user.Username, err), "", "invalid-password", common.GetComponentInfo())
+	}
+	return
+}
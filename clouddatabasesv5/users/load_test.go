@@ -0,0 +1,113 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package users
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedUsers = []User{
+	{Username: "app", Password: PasswordSource{Env: "APP_PASSWORD"}},
+	{Username: "cache", Type: TypeRedis, Role: "+@read ~cache:*", Password: PasswordSource{Generate: true}},
+	{Username: "ops", Type: TypeOpsManager, Password: PasswordSource{File: "/run/secrets/ops"}},
+}
+
+func TestLoadFormats(t *testing.T) {
+	documents := map[Format][]string{
+		FormatYAML: {
+			`
+- username: app
+  password: {env: APP_PASSWORD}
+- username: cache
+  type: redis
+  role: "+@read ~cache:*"
+  password: {generate: true}
+- username: ops
+  type: ops_manager
+  password: {file: /run/secrets/ops}
+`,
+			`
+users:
+  - {username: app, password: {env: APP_PASSWORD}}
+  - {username: cache, type: redis, role: "+@read ~cache:*", password: {generate: true}}
+  - {username: ops, type: ops_manager, password: {file: /run/secrets/ops}}
+`,
+		},
+		FormatJSON: {
+			`[{"username": "app", "password": {"env": "APP_PASSWORD"}}, {"username": "cache", "type": "redis", "role": "+@read ~cache:*", "password": {"generate": true}}, {"username": "ops", "type": "ops_manager", "password": {"file": "/run/secrets/ops"}}]`,
+			`{"users": [{"username": "app", "password": {"env": "APP_PASSWORD"}}, {"username": "cache", "type": "redis", "role": "+@read ~cache:*", "password": {"generate": true}}, {"username": "ops", "type": "ops_manager", "password": {"file": "/run/secrets/ops"}}]}`,
+		},
+	}
+	for format, formatDocuments := range documents {
+		for _, document := range formatDocuments {
+			users, err := Load(strings.NewReader(document), format)
+			require.Nil(t, err, document)
+			assert.Equal(t, expectedUsers, users, document)
+		}
+	}
+
+	users, err := Load(strings.NewReader(""), FormatYAML)
+	require.Nil(t, err)
+	assert.Empty(t, users)
+}
+
+func TestLoadErrors(t *testing.T) {
+	documents := map[string]Format{
+		`- {username: app, pasword: {generate: true}}`:                 FormatYAML,
+		`- {password: {generate: true}}`:                               FormatYAML,
+		`- {username: app, type: admin, password: {generate: true}}`:   FormatYAML,
+		`- {username: app, role: "+@all", password: {generate: true}}`: FormatYAML,
+		`- {username: app}`: FormatYAML,
+		`- {username: app, password: {value: a-long-password-123, generate: true}}`:                                                   FormatYAML,
+		`[{"username": "app", "password": {"generate": true}}, {"username": "app", "type": "redis", "password": {"generate": true}}]`: FormatJSON,
+		`- {username: cache, type: redis, role: "+@nope", password: {generate: true}}`:                                                FormatYAML,
+		`username: app`: FormatYAML,
+		`[]`:            "toml",
+	}
+	for document, format := range documents {
+		_, err := Load(strings.NewReader(document), format)
+		assert.NotNil(t, err, document)
+	}
+
+	_, err := Load(strings.NewReader(`- {username: app}`), FormatYAML)
+	assert.True(t, errors.Is(err, clouddatabasesv5.ErrValidation))
+	_, err = Load(strings.NewReader(`- {username: cache, type: redis, role: "+@all >secret", password: {generate: true}}`), FormatYAML)
+	assert.True(t, errors.Is(err, clouddatabasesv5.ErrInvalidRedisRole))
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "users.yml")
+	require.Nil(t, os.WriteFile(path, []byte("- {username: app, password: {env: APP_PASSWORD}}\n"), 0600))
+
+	users, err := LoadFile(path)
+	require.Nil(t, err)
+	assert.Equal(t, expectedUsers[:1], users)
+
+	_, err = LoadFile(filepath.Join(dir, "users.txt"))
+	assert.NotNil(t, err)
+	_, err = LoadFile(filepath.Join(dir, "missing.json"))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,303 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package users
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5/internal/declarative"
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AdminUsername is the username of the admin user of a deployment, which is never deleted.
+const AdminUsername = "admin"
+
+// CurrentUser : A user that the deployment has. The API has no operation that lists the users of a deployment, so the
+// current users are supplied by the caller, for example from an inventory kept along with the spec.
+type CurrentUser struct {
+	// Username of the user.
+	Username string `json:"username"`
+
+	// The user type of the user in the API: TypeDatabase or TypeOpsManager. Defaults to TypeDatabase.
+	Type string `json:"type,omitempty"`
+
+	// The current role of a Redis user, in Redis ACL syntax. A desired role always differs from an empty role.
+	Role string `json:"role,omitempty"`
+}
+
+// Plan : The changes that make the users of a deployment match the desired users.
+// Users are matched on their API user type and username.
+type Plan struct {
+	// The ID of the deployment.
+	DeploymentID string
+
+	// The users of the deployment when the plan was made, as supplied to NewPlan.
+	Current []CurrentUser
+
+	// The desired users.
+	Desired []User
+
+	// Desired users that are missing from the deployment, with their password.
+	Create []Creation
+
+	// Redis users whose current role differs from the desired role, once both are in canonical form.
+	UpdateRole []RoleChange
+
+	// Users that are not desired and are listed in PlanOptions.Prune: they are deleted.
+	Delete []CurrentUser
+
+	// Users that are not desired and are not listed in PlanOptions.Prune: they are kept.
+	Unmanaged []CurrentUser
+}
+
+// Creation : A user to create.
+type Creation struct {
+	// The desired user.
+	User User
+
+	// The password of the user, read from its source or generated when the plan was made. Store generated passwords
+	// before applying the plan: they cannot be read back from the deployment.
+	Password string
+}
+
+// RoleChange : A change to the role of a Redis user.
+type RoleChange struct {
+	// The desired user.
+	User User
+
+	// The current role.
+	Current string
+}
+
+// PlanOptions : The NewPlan options.
+type PlanOptions struct {
+	// The usernames of the users that may be deleted when they are not desired. Users are never deleted unless they
+	// are listed here, and AdminUsername cannot be listed.
+	Prune []string
+}
+
+// ApplyOptions : The Plan.Apply options.
+type ApplyOptions struct {
+	// Only write the diff of the plan to Output, without making any change.
+	DryRun bool
+
+	// Where the diff is written in dry-run mode. Required when DryRun is set.
+	Output io.Writer
+
+	// The options used to wait for each task. See clouddatabasesv5.CloudDatabasesV5.WaitForTask.
+	WaitForTaskOptions *clouddatabasesv5.WaitForTaskOptions
+}
+
+// NewPlan compares the current users of the deployment with the desired users, as returned by Load. The passwords of
+// the users to create are resolved, and checked against the policy of their type.
+func NewPlan(id string, current []CurrentUser, desired []User, options *PlanOptions) (plan *Plan, err error) {
+	if options == nil {
+		options = &PlanOptions{}
+	}
+	err = Validate(desired)
+	if err != nil {
+		return
+	}
+	prune := map[string]bool{}
+	for _, username := range options.Prune {
+		if username == AdminUsername {
+			err = fmt.Errorf("%w: user '%s' cannot be pruned", clouddatabasesv5.ErrValidation, username)
+			err = core.SDKErrorf(err, "", "invalid-prune", common.GetComponentInfo())
+			return
+		}
+		prune[username] = true
+	}
+
+	plan = &Plan{
+		DeploymentID: id,
+		Current:      current,
+		Desired:      desired,
+	}
+
+	currentByKey := map[string]CurrentUser{}
+	for _, user := range current {
+		currentByKey[userKey(user.apiType(), user.Username)] = user
+	}
+	desiredByKey := map[string]bool{}
+	for _, user := range desired {
+		key := userKey(user.apiType(), user.Username)
+		desiredByKey[key] = true
+		currentUser, ok := currentByKey[key]
+		if !ok {
+			var password string
+			password, err = user.resolvePassword()
+			if err != nil {
+				plan = nil
+				return
+			}
+			plan.Create = append(plan.Create, Creation{User: user, Password: password})
+		} else if user.Role != "" && canonicalRole(user.Role) != canonicalRole(currentUser.Role) {
+			plan.UpdateRole = append(plan.UpdateRole, RoleChange{User: user, Current: currentUser.Role})
+		}
+	}
+	for _, user := range current {
+		if desiredByKey[userKey(user.apiType(), user.Username)] {
+			continue
+		}
+		if prune[user.Username] {
+			plan.Delete = append(plan.Delete, user)
+		} else {
+			plan.Unmanaged = append(plan.Unmanaged, user)
+		}
+	}
+	return
+}
+
+// IsEmpty returns true if the users already match the desired users.
+func (plan *Plan) IsEmpty() bool {
+	return len(plan.Create) == 0 && len(plan.UpdateRole) == 0 && len(plan.Delete) == 0
+}
+
+// String returns a readable diff of the plan: one line per created ("+"), deleted ("-") or updated ("~") user.
+// Passwords are not shown.
+func (plan *Plan) String() string {
+	builder := &strings.Builder{}
+	fmt.Fprintf(builder, "Users of %s:\n", plan.DeploymentID)
+	if plan.IsEmpty() {
+		builder.WriteString("  no changes\n")
+	} else {
+		writer := tabwriter.NewWriter(builder, 0, 0, 2, ' ', 0)
+		for _, creation := range plan.Create {
+			fmt.Fprintf(writer, "+ %s\t%s%s\n", creation.User.Username, creation.User.apiType(), formatRole(creation.User.Role))
+		}
+		for _, change := range plan.UpdateRole {
+			fmt.Fprintf(writer, "~ %s\t%s\t%q -> %q\n", change.User.Username, change.User.apiType(), change.Current, change.User.Role)
+		}
+		for _, user := range plan.Delete {
+			fmt.Fprintf(writer, "- %s\t%s%s\n", user.Username, user.apiType(), formatRole(user.Role))
+		}
+		writer.Flush()
+		fmt.Fprintf(builder, "%d to create, %d to update, %d to delete\n", len(plan.Create), len(plan.UpdateRole), len(plan.Delete))
+	}
+	if len(plan.Unmanaged) > 0 {
+		fmt.Fprintf(builder, "%d unmanaged users kept\n", len(plan.Unmanaged))
+	}
+	return builder.String()
+}
+
+// Apply creates, updates and deletes the users of the plan, in this order, and waits for each resulting task. It
+// returns the completed tasks, which are also returned along with the error if a request or a task fails. Nothing is
+// changed when the plan is empty or in dry-run mode.
+func (plan *Plan) Apply(ctx context.Context, api clouddatabasesv5.CloudDatabasesAPI, options *ApplyOptions) (tasks []clouddatabasesv5.Task, err error) {
+	if options == nil {
+		options = &ApplyOptions{}
+	}
+
+	if options.DryRun {
+		err = declarative.WriteDryRun(options.Output, plan)
+		return
+	}
+
+	for _, creation := range plan.Create {
+		createDatabaseUserOptions := (*clouddatabasesv5.CloudDatabasesV5)(nil).NewCreateDatabaseUserOptions(plan.DeploymentID, creation.User.apiType())
+		createDatabaseUserOptions.SetUser(creation.model())
+		_, task, _, err := api.CreateDatabaseUserAndWait(ctx, createDatabaseUserOptions, options.WaitForTaskOptions)
+		tasks = appendTask(tasks, task)
+		if err != nil {
+			return tasks, core.RepurposeSDKProblem(err, "create-user-error")
+		}
+	}
+	for _, change := range plan.UpdateRole {
+		updateUserOptions := (*clouddatabasesv5.CloudDatabasesV5)(nil).NewUpdateUserOptions(plan.DeploymentID, change.User.apiType(), change.User.Username)
+		updateUserOptions.SetUser(&clouddatabasesv5.UserUpdateRedisRoleSetting{Role: core.StringPtr(change.User.Role)})
+		_, task, _, err := api.UpdateUserAndWait(ctx, updateUserOptions, options.WaitForTaskOptions)
+		tasks = appendTask(tasks, task)
+		if err != nil {
+			return tasks, core.RepurposeSDKProblem(err, "update-user-error")
+		}
+	}
+	for _, user := range plan.Delete {
+		deleteDatabaseUserOptions := (*clouddatabasesv5.CloudDatabasesV5)(nil).NewDeleteDatabaseUserOptions(plan.DeploymentID, user.apiType(), user.Username)
+		_, task, _, err := api.DeleteDatabaseUserAndWait(ctx, deleteDatabaseUserOptions, options.WaitForTaskOptions)
+		tasks = appendTask(tasks, task)
+		if err != nil {
+			return tasks, core.RepurposeSDKProblem(err, "delete-user-error")
+		}
+	}
+	return
+}
+
+// Sync makes a plan for the deployment and applies it. The plan is returned along with the tasks.
+func Sync(ctx context.Context, api clouddatabasesv5.CloudDatabasesAPI, id string, current []CurrentUser, desired []User, planOptions *PlanOptions, applyOptions *ApplyOptions) (plan *Plan, tasks []clouddatabasesv5.Task, err error) {
+	return declarative.Sync(func() (*Plan, error) {
+		return NewPlan(id, current, desired, planOptions)
+	}, func(plan *Plan) ([]clouddatabasesv5.Task, error) {
+		return plan.Apply(ctx, api, applyOptions)
+	})
+}
+
+// model returns the model of the user to create.
+func (creation Creation) model() clouddatabasesv5.UserIntf {
+	username, password := core.StringPtr(creation.User.Username), core.StringPtr(creation.Password)
+	switch creation.User.Type {
+	case TypeOpsManager:
+		return &clouddatabasesv5.UserOpsManagerUser{Username: username, Password: password}
+	case TypeRedis:
+		user := &clouddatabasesv5.UserRedisDatabaseUser{Username: username, Password: password}
+		if creation.User.Role != "" {
+			user.Role = core.StringPtr(creation.User.Role)
+		}
+		return user
+	}
+	return &clouddatabasesv5.UserDatabaseUser{Username: username, Password: password}
+}
+
+// apiType returns the user type of the user in the API.
+func (user CurrentUser) apiType() string {
+	if user.Type == TypeOpsManager {
+		return TypeOpsManager
+	}
+	return TypeDatabase
+}
+
+func userKey(userType string, username string) string {
+	return userType + "/" + username
+}
+
+// canonicalRole returns the canonical form of a role, or the role as is if it is malformed.
+func canonicalRole(role string) string {
+	if canonical, err := clouddatabasesv5.CanonicalRedisRole(role); err == nil {
+		return canonical
+	}
+	return role
+}
+
+// formatRole returns the role as a last column of the diff, if there is one.
+func formatRole(role string) string {
+	if role == "" {
+		return ""
+	}
+	return fmt.Sprintf("\t%q", role)
+}
+
+func appendTask(tasks []clouddatabasesv5.Task, task *clouddatabasesv5.Task) []clouddatabasesv5.Task {
+	if task != nil {
+		tasks = append(tasks, *task)
+	}
+	return tasks
+}
@@ -0,0 +1,181 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package users
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5/fake"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const deploymentID = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/abc123:d1a2b3c4-0000-4000-8000-000000000001::"
+
+func newTestService(t *testing.T) (*fake.Server, *clouddatabasesv5.CloudDatabasesV5) {
+	server, service := fake.NewTestService(t, nil, clouddatabasesv5.Deployment{ID: core.StringPtr(deploymentID), Type: core.StringPtr("redis")})
+
+	for _, user := range []clouddatabasesv5.UserIntf{
+		&clouddatabasesv5.UserDatabaseUser{Username: core.StringPtr(AdminUsername), Password: core.StringPtr("admin-password-123")},
+		&clouddatabasesv5.UserRedisDatabaseUser{Username: core.StringPtr("cache"), Password: core.StringPtr("cache-password-123"), Role: core.StringPtr("+@all")},
+		&clouddatabasesv5.UserDatabaseUser{Username: core.StringPtr("legacy"), Password: core.StringPtr("legacy-password-123")},
+	} {
+		createDatabaseUserOptions := service.NewCreateDatabaseUserOptions(deploymentID, "database").SetUser(user)
+		_, _, _, err := service.CreateDatabaseUserAndWait(context.Background(), createDatabaseUserOptions, newApplyOptions().WaitForTaskOptions)
+		require.Nil(t, err)
+	}
+	return server, service
+}
+
+func newApplyOptions() *ApplyOptions {
+	return &ApplyOptions{
+		WaitForTaskOptions: (*clouddatabasesv5.CloudDatabasesV5)(nil).NewWaitForTaskOptions().SetPollInterval(time.Millisecond),
+	}
+}
+
+// currentUsers are the users created by newTestService.
+var currentUsers = []CurrentUser{
+	{Username: AdminUsername},
+	{Username: "cache", Role: "+@all"},
+	{Username: "legacy"},
+}
+
+var desiredUsers = []User{
+	{Username: "app", Password: PasswordSource{Value: "app-password-123"}},
+	{Username: "cache", Type: TypeRedis, Role: "+@read ~cache:*", Password: PasswordSource{Generate: true}},
+	{Username: "reader", Type: TypeRedis, Role: "+@read", Password: PasswordSource{Generate: true}},
+}
+
+func TestPlan(t *testing.T) {
+	_, service := newTestService(t)
+
+	plan, err := NewPlan(deploymentID, currentUsers, desiredUsers, nil)
+	require.Nil(t, err)
+	require.Len(t, plan.Create, 2)
+	assert.Equal(t, desiredUsers[0], plan.Create[0].User)
+	assert.Equal(t, "app-password-123", plan.Create[0].Password)
+	assert.Equal(t, desiredUsers[2], plan.Create[1].User)
+	assert.Nil(t, clouddatabasesv5.DatabasePasswordPolicy.Validate(plan.Create[1].Password))
+	assert.Equal(t, []RoleChange{{User: desiredUsers[1], Current: "+@all"}}, plan.UpdateRole)
+	assert.Empty(t, plan.Delete)
+	assert.Len(t, plan.Unmanaged, 2)
+	assert.False(t, plan.IsEmpty())
+
+	output := &bytes.Buffer{}
+	tasks, err := plan.Apply(context.Background(), service, &ApplyOptions{DryRun: true, Output: output})
+	require.Nil(t, err)
+	assert.Empty(t, tasks)
+	assert.Equal(t, "Users of "+deploymentID+":\n"+
+		"+ app     database\n"+
+		`+ reader  database  "+@read"`+"\n"+
+		`~ cache   database  "+@all" -> "+@read ~cache:*"`+"\n"+
+		"2 to create, 1 to update, 0 to delete\n"+
+		"2 unmanaged users kept\n", output.String())
+	assert.NotContains(t, output.String(), plan.Create[1].Password)
+
+	_, err = plan.Apply(context.Background(), service, &ApplyOptions{DryRun: true})
+	assert.NotNil(t, err)
+
+	plan, err = NewPlan(deploymentID, currentUsers, []User{
+		{Username: "cache", Type: TypeRedis, Role: "ALLCOMMANDS", Password: PasswordSource{Generate: true}},
+	}, nil)
+	require.Nil(t, err)
+	assert.True(t, plan.IsEmpty())
+}
+
+func TestPrune(t *testing.T) {
+	// Only the users listed in Prune are deleted.
+	plan, err := NewPlan(deploymentID, currentUsers, desiredUsers, &PlanOptions{Prune: []string{"legacy", "unknown"}})
+	require.Nil(t, err)
+	assert.Equal(t, []CurrentUser{{Username: "legacy"}}, plan.Delete)
+	assert.Equal(t, []CurrentUser{{Username: AdminUsername}}, plan.Unmanaged)
+	assert.Contains(t, plan.String(), "- legacy  database\n")
+
+	plan, err = NewPlan(deploymentID, currentUsers, nil, nil)
+	require.Nil(t, err)
+	assert.Empty(t, plan.Delete)
+	assert.Len(t, plan.Unmanaged, 3)
+
+	_, err = NewPlan(deploymentID, currentUsers, desiredUsers, &PlanOptions{Prune: []string{AdminUsername}})
+	assert.True(t, errors.Is(err, clouddatabasesv5.ErrValidation))
+}
+
+func TestSync(t *testing.T) {
+	server, service := newTestService(t)
+
+	plan, tasks, err := Sync(context.Background(), service, deploymentID, currentUsers, desiredUsers, &PlanOptions{Prune: []string{"legacy"}}, newApplyOptions())
+	require.Nil(t, err)
+	assert.Len(t, tasks, 4)
+	assert.Equal(t, []string{AdminUsername, "app", "cache", "reader"}, server.Users(deploymentID, "database"))
+	password, _ := server.UserPassword(deploymentID, "database", "reader")
+	assert.Equal(t, plan.Create[1].Password, password)
+	role, _ := server.UserRole(deploymentID, "database", "cache")
+	assert.Equal(t, "+@read ~cache:*", role)
+	role, _ = server.UserRole(deploymentID, "database", "reader")
+	assert.Equal(t, "+@read", role)
+
+	current := []CurrentUser{
+		{Username: AdminUsername},
+		{Username: "app"},
+		{Username: "cache", Role: "+@read ~cache:*"},
+		{Username: "reader", Role: "+@read"},
+	}
+	plan, tasks, err = Sync(context.Background(), service, deploymentID, current, desiredUsers, &PlanOptions{Prune: []string{"legacy"}}, newApplyOptions())
+	require.Nil(t, err)
+	assert.True(t, plan.IsEmpty())
+	assert.Empty(t, tasks)
+	assert.Contains(t, plan.String(), "no changes")
+}
+
+func TestApplyFailure(t *testing.T) {
+	server, service := newTestService(t)
+	server.FailNextTask(deploymentID)
+
+	_, tasks, err := Sync(context.Background(), service, deploymentID, currentUsers, desiredUsers, nil, newApplyOptions())
+	assert.NotNil(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, clouddatabasesv5.TaskStatusFailedConst, *tasks[0].Status)
+}
+
+func TestPasswordSources(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "password")
+	require.Nil(t, os.WriteFile(path, []byte("file-password-123\n"), 0600))
+	t.Setenv("USERS_TEST_PASSWORD", "env-password-123")
+
+	plan, err := NewPlan(deploymentID, nil, []User{
+		{Username: "from_env", Password: PasswordSource{Env: "USERS_TEST_PASSWORD"}},
+		{Username: "from_file", Password: PasswordSource{File: path}},
+		{Username: "ops", Type: TypeOpsManager, Password: PasswordSource{Generate: true}},
+	}, nil)
+	require.Nil(t, err)
+	assert.Equal(t, "env-password-123", plan.Create[0].Password)
+	assert.Equal(t, "file-password-123", plan.Create[1].Password)
+	assert.Nil(t, clouddatabasesv5.OpsManagerPasswordPolicy.Validate(plan.Create[2].Password))
+
+	for _, source := range []PasswordSource{{Env: "USERS_TEST_MISSING"}, {File: path + ".missing"}, {Value: "short"}} {
+		_, err = NewPlan(deploymentID, nil, []User{{Username: "app", Password: source}}, nil)
+		assert.NotNil(t, err, source)
+	}
+}
@@ -0,0 +1,210 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package crn parses and validates the Cloud Resource Names (CRNs) used as deployment, task and backup IDs on the
+// IBM Cloud Databases v5 API platform.
+//
+// A CRN has the form
+//
+//	crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource
+//
+// for example crn:v1:bluemix:public:databases-for-postgresql:us-south:a/<account>:<instance-guid>::
+package crn
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/IBM/cloud-databases-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Prefix is the first segment of every CRN.
+const Prefix = "crn"
+
+// Version is the only CRN version that is supported.
+const Version = "v1"
+
+// accountScopePrefix marks a scope segment that names an account.
+const accountScopePrefix = "a/"
+
+// segmentCount is the number of colon-separated segments in a CRN.
+const segmentCount = 10
+
+// ErrInvalidCRN is wrapped by every error returned for a malformed CRN; use errors.Is to detect it.
+var ErrInvalidCRN = errors.New("invalid CRN")
+
+// CRN : The parsed segments of a Cloud Resource Name.
+type CRN struct {
+	// The CRN version, always "v1".
+	Version string
+
+	// The cloud name, for example "bluemix".
+	CName string
+
+	// The cloud type, for example "public".
+	CType string
+
+	// The service name, for example "databases-for-postgresql".
+	ServiceName string
+
+	// The region of the resource, for example "us-south".
+	Region string
+
+	// The scope of the resource, for example "a/<account>".
+	Scope string
+
+	// The GUID of the service instance.
+	ServiceInstance string
+
+	// The type of the resource within the service instance, for example "task" or "backup". Empty for a deployment.
+	ResourceType string
+
+	// The resource within the service instance. Empty for a deployment.
+	Resource string
+}
+
+// IsCRN returns true if the specified ID has the form of a CRN rather than, for example, a Compose style UUID.
+// It does not check that the CRN is valid.
+func IsCRN(id string) bool {
+	return strings.HasPrefix(id, Prefix+":")
+}
+
+// Parse parses and validates a CRN.
+func Parse(s string) (*CRN, error) {
+	segments := strings.Split(s, ":")
+	if len(segments) != segmentCount {
+		return nil, invalidError(s, fmt.Sprintf("expected %d segments separated by ':', found %d", segmentCount, len(segments)))
+	}
+	if segments[0] != Prefix {
+		return nil, invalidError(s, fmt.Sprintf("must start with '%s:'", Prefix))
+	}
+
+	crn := &CRN{
+		Version:         segments[1],
+		CName:           segments[2],
+		CType:           segments[3],
+		ServiceName:     segments[4],
+		Region:          segments[5],
+		Scope:           segments[6],
+		ServiceInstance: segments[7],
+		ResourceType:    segments[8],
+		Resource:        segments[9],
+	}
+	err := crn.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return crn, nil
+}
+
+// MustParse is like Parse but panics if the CRN is not valid. It is intended for constants in tests and examples.
+func MustParse(s string) *CRN {
+	crn, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return crn
+}
+
+// ValidateID returns an error if the specified ID looks like a CRN but is not a valid one. IDs that are not CRNs,
+// such as the Compose style UUIDs of the Compose v5 API platform, are accepted.
+func ValidateID(id string) error {
+	if !IsCRN(id) {
+		return nil
+	}
+	_, err := Parse(id)
+	return err
+}
+
+// Validate returns an error if a required segment of the CRN is missing or malformed.
+func (crn *CRN) Validate() error {
+	if crn.Version != Version {
+		return invalidError(crn.String(), fmt.Sprintf("unsupported version '%s'", crn.Version))
+	}
+	required := []struct {
+		name  string
+		value string
+	}{
+		{"cname", crn.CName},
+		{"ctype", crn.CType},
+		{"service name", crn.ServiceName},
+		{"region", crn.Region},
+		{"service instance", crn.ServiceInstance},
+	}
+	for _, segment := range required {
+		if segment.value == "" {
+			return invalidError(crn.String(), fmt.Sprintf("missing %s", segment.name))
+		}
+	}
+	if strings.HasPrefix(crn.Scope, accountScopePrefix) && crn.AccountID() == "" {
+		return invalidError(crn.String(), "missing account in scope")
+	}
+	if crn.Resource != "" && crn.ResourceType == "" {
+		return invalidError(crn.String(), "missing resource type")
+	}
+	return nil
+}
+
+// AccountID returns the account named in the scope of the CRN, or an empty string if the scope is not an account.
+func (crn *CRN) AccountID() string {
+	if !strings.HasPrefix(crn.Scope, accountScopePrefix) {
+		return ""
+	}
+	return strings.TrimPrefix(crn.Scope, accountScopePrefix)
+}
+
+// InstanceGUID returns the GUID of the service instance.
+func (crn *CRN) InstanceGUID() string {
+	return crn.ServiceInstance
+}
+
+// Deployment returns the CRN of the deployment that the resource belongs to, without the resource type and resource.
+func (crn *CRN) Deployment() *CRN {
+	deployment := *crn
+	deployment.ResourceType = ""
+	deployment.Resource = ""
+	return &deployment
+}
+
+// String returns the CRN in its canonical form.
+func (crn *CRN) String() string {
+	return strings.Join([]string{
+		Prefix,
+		crn.Version,
+		crn.CName,
+		crn.CType,
+		crn.ServiceName,
+		crn.Region,
+		crn.Scope,
+		crn.ServiceInstance,
+		crn.ResourceType,
+		crn.Resource,
+	}, ":")
+}
+
+// PathEscape returns the CRN escaped for use as a URL path segment. The scope contains a forward slash (/), which
+// must be escaped when the CRN is used in a path.
+func (crn *CRN) PathEscape() string {
+	return url.PathEscape(crn.String())
+}
+
+func invalidError(s string, reason string) error {
+	err := fmt.Errorf("%w '%s': %s", ErrInvalidCRN, s, reason)
+	return core.SDKErrorf(err, "", "invalid-crn", common.GetComponentInfo())
+}
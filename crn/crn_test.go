@@ -0,0 +1,88 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crn
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const deploymentCRN = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/274074dce64e9c423ffc238516c755e1:29caf0e7-120f-4da8-9551-3abf57ebcfc7::"
+const taskCRN = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/274074dce64e9c423ffc238516c755e1:29caf0e7-120f-4da8-9551-3abf57ebcfc7:task:d9e4a3c8-6d5e-4c1b-8d1e-b3a6d5c6e2f1"
+
+func TestParseDeployment(t *testing.T) {
+	crn, err := Parse(deploymentCRN)
+	require.Nil(t, err)
+	assert.Equal(t, "v1", crn.Version)
+	assert.Equal(t, "bluemix", crn.CName)
+	assert.Equal(t, "public", crn.CType)
+	assert.Equal(t, "databases-for-postgresql", crn.ServiceName)
+	assert.Equal(t, "us-south", crn.Region)
+	assert.Equal(t, "274074dce64e9c423ffc238516c755e1", crn.AccountID())
+	assert.Equal(t, "29caf0e7-120f-4da8-9551-3abf57ebcfc7", crn.InstanceGUID())
+	assert.Empty(t, crn.ResourceType)
+	assert.Equal(t, deploymentCRN, crn.String())
+	assert.Equal(t, "crn:v1:bluemix:public:databases-for-postgresql:us-south:a%2F274074dce64e9c423ffc238516c755e1:29caf0e7-120f-4da8-9551-3abf57ebcfc7::", crn.PathEscape())
+}
+
+func TestParseResource(t *testing.T) {
+	crn, err := Parse(taskCRN)
+	require.Nil(t, err)
+	assert.Equal(t, "task", crn.ResourceType)
+	assert.Equal(t, "d9e4a3c8-6d5e-4c1b-8d1e-b3a6d5c6e2f1", crn.Resource)
+	assert.Equal(t, deploymentCRN, crn.Deployment().String())
+	assert.Equal(t, taskCRN, crn.String())
+}
+
+func TestParseInvalid(t *testing.T) {
+	invalid := map[string]string{
+		"too few segments":  "crn:v1:bluemix:public:databases-for-postgresql:us-south",
+		"wrong prefix":      "arn:v1:bluemix:public:databases-for-postgresql:us-south:a/1:guid::",
+		"wrong version":     "crn:v2:bluemix:public:databases-for-postgresql:us-south:a/1:guid::",
+		"no region":         "crn:v1:bluemix:public:databases-for-postgresql::a/1:guid::",
+		"no instance":       "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/1:::",
+		"empty account":     "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/:guid::",
+		"no resource type":  "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/1:guid::task-id",
+		"no service name":   "crn:v1:bluemix:public::us-south:a/1:guid::",
+		"a URL escaped CRN": "crn%3Av1%3Abluemix",
+	}
+	for name, id := range invalid {
+		t.Run(name, func(t *testing.T) {
+			crn, err := Parse(id)
+			assert.Nil(t, crn)
+			require.NotNil(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidCRN))
+
+			var sdkProblem *core.SDKProblem
+			require.True(t, errors.As(err, &sdkProblem))
+			assert.Contains(t, sdkProblem.Summary, id)
+		})
+	}
+	assert.Panics(t, func() { MustParse("crn:v1") })
+}
+
+func TestValidateID(t *testing.T) {
+	assert.Nil(t, ValidateID(deploymentCRN))
+	assert.Nil(t, ValidateID("3c1b5ee7-1f5e-4d6a-9f2c-2b0c8c0a4f7e"))
+	assert.NotNil(t, ValidateID("crn:v1:bluemix:public"))
+	assert.True(t, IsCRN(deploymentCRN))
+	assert.False(t, IsCRN("3c1b5ee7-1f5e-4d6a-9f2c-2b0c8c0a4f7e"))
+}